@@ -0,0 +1,240 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/appdiscovery"
+	"net/http"
+	"sort"
+)
+
+func dataSourceNsxtAppDiscoverySession() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtAppDiscoverySessionRead,
+
+		Schema: map[string]*schema.Schema{
+			"session_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the app discovery session",
+				Required:    true,
+			},
+			"status":          getAppDiscoverySessionStatusSchema(),
+			"start_timestamp": getAppDiscoverySessionStartTimestampSchema(),
+			"end_timestamp":   getAppDiscoverySessionEndTimestampSchema(),
+			"ns_group": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "NSGroups scanned in this session",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ns_group_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "ID of the NSGroup",
+							Computed:    true,
+						},
+						"vm_count": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Number of virtual machines in the NSGroup",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"app_profile": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "App profiles used in this session",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_profile_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "ID of the app profile",
+							Computed:    true,
+						},
+						"app_profile_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Name of the app profile",
+							Computed:    true,
+						},
+						"app_profile_category": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Category of the app profile",
+							Computed:    true,
+						},
+						"installed_apps_count": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Number of installed apps matching the app profile",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"installed_app": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Apps discovered in this session",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Name of the app",
+							Computed:    true,
+						},
+						"version": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Version of the app",
+							Computed:    true,
+						},
+						"manufacturer": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Manufacturer of the app",
+							Computed:    true,
+						},
+						"vm_ids": &schema.Schema{
+							Type:        schema.TypeList,
+							Description: "IDs of the virtual machines the app is installed on",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"virtual_machine": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Virtual machines scanned in this session, with the apps discovered on each",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vm_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "ID of the virtual machine",
+							Computed:    true,
+						},
+						"installed_apps": &schema.Schema{
+							Type:        schema.TypeList,
+							Description: "Names of the apps discovered on the virtual machine",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getAppDiscoverySessionInstalledApps(nsxClient *api.APIClient, sessionID string) ([]appdiscovery.AppInfo, *http.Response, error) {
+	var apps []appdiscovery.AppInfo
+	resp, err := readAllPages(make(map[string]interface{}), func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.AppDiscoveryApi.GetAppDiscoverySessionInstalledApps(nsxClient.Context, sessionID, localVarOptionals)
+		apps = append(apps, result.Results...)
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	return apps, resp, nil
+}
+
+func setAppDiscoverySessionAppProfilesInSchema(d *schema.ResourceData, summary appdiscovery.AppDiscoverySessionResultSummary) error {
+	appsCount := make(map[string]int64)
+	for _, profileSummary := range summary.AppProfileSummaryList {
+		appsCount[profileSummary.AppProfileId] = profileSummary.InstalledAppsCount
+	}
+
+	var profileList []map[string]interface{}
+	for _, appProfile := range summary.AppProfiles {
+		elem := make(map[string]interface{})
+		elem["app_profile_id"] = appProfile.AppProfileId
+		elem["app_profile_name"] = appProfile.AppProfileName
+		elem["app_profile_category"] = appProfile.AppProfileCategory
+		elem["installed_apps_count"] = appsCount[appProfile.AppProfileId]
+		profileList = append(profileList, elem)
+	}
+	return d.Set("app_profile", profileList)
+}
+
+func setAppDiscoverySessionAppsInSchema(d *schema.ResourceData, apps []appdiscovery.AppInfo) error {
+	var appList []map[string]interface{}
+	vmApps := make(map[string][]string)
+	for _, app := range apps {
+		elem := make(map[string]interface{})
+		elem["name"] = app.Name
+		elem["version"] = app.Version
+		elem["manufacturer"] = app.Manufacturer
+		elem["vm_ids"] = app.VmIds
+		appList = append(appList, elem)
+
+		for _, vmID := range app.VmIds {
+			vmApps[vmID] = append(vmApps[vmID], app.Name)
+		}
+	}
+	err := d.Set("installed_app", appList)
+	if err != nil {
+		return err
+	}
+
+	// Sort the virtual machines to keep the list stable between reads
+	var vmIDs []string
+	for vmID := range vmApps {
+		vmIDs = append(vmIDs, vmID)
+	}
+	sort.Strings(vmIDs)
+	var vmList []map[string]interface{}
+	for _, vmID := range vmIDs {
+		elem := make(map[string]interface{})
+		elem["vm_id"] = vmID
+		elem["installed_apps"] = vmApps[vmID]
+		vmList = append(vmList, elem)
+	}
+	return d.Set("virtual_machine", vmList)
+}
+
+func dataSourceNsxtAppDiscoverySessionRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	sessionID := d.Get("session_id").(string)
+
+	summary, resp, err := nsxClient.AppDiscoveryApi.GetAppDiscoverySessionSummary(nsxClient.Context, sessionID)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("App discovery session %s was not found", sessionID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading app discovery session %s: %v", sessionID, err)
+	}
+
+	apps, _, err := getAppDiscoverySessionInstalledApps(nsxClient, sessionID)
+	if err != nil {
+		return fmt.Errorf("Error while reading installed apps of app discovery session %s: %v", sessionID, err)
+	}
+
+	d.SetId(sessionID)
+	d.Set("status", summary.Status)
+	d.Set("start_timestamp", summary.StartTimestamp)
+	d.Set("end_timestamp", summary.EndTimestamp)
+
+	var nsGroupList []map[string]interface{}
+	for _, nsGroup := range summary.NsGroups {
+		elem := make(map[string]interface{})
+		elem["ns_group_id"] = nsGroup.NsGroupId
+		elem["vm_count"] = nsGroup.NoOfVms
+		nsGroupList = append(nsGroupList, elem)
+	}
+	err = d.Set("ns_group", nsGroupList)
+	if err != nil {
+		return fmt.Errorf("Error during app discovery session NS groups set in schema: %v", err)
+	}
+	err = setAppDiscoverySessionAppProfilesInSchema(d, summary)
+	if err != nil {
+		return fmt.Errorf("Error during app discovery session app profiles set in schema: %v", err)
+	}
+	err = setAppDiscoverySessionAppsInSchema(d, apps)
+	if err != nil {
+		return fmt.Errorf("Error during app discovery session installed apps set in schema: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtAppDiscoverySession_basic(t *testing.T) {
+	testResourceName := "data.nsxt_app_discovery_session.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXAppDiscoverySessionReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "status", "FINISHED"),
+					resource.TestCheckResourceAttr(testResourceName, "ns_group.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "app_profile.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "app_profile.0.app_profile_name", "app-discovery-test-profile"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXAppDiscoverySessionReadTemplate() string {
+	return testAccNSXAppDiscoverySessionCreateTemplate() + `
+
+data "nsxt_app_discovery_session" "test" {
+  session_id = "${nsxt_app_discovery_session.test.id}"
+}`
+}
@@ -0,0 +1,134 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtDhcpServerLeases() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtDhcpServerLeasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_dhcp_server_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the logical DHCP server",
+				Required:    true,
+			},
+			"address": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Only return the leases of this IP address, IP range or MAC address",
+				Optional:    true,
+			},
+			"pool_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Only return the leases of this DHCP server IP pool",
+				Optional:    true,
+			},
+			"source": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Data source type of the leases (realtime or cached)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(firewallStatsSourceValues, false),
+			},
+			"timestamp": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Timestamp of the lease information, in milliseconds since epoch",
+				Computed:    true,
+			},
+			"lease": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Leases of the DHCP server",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "IP address of the client",
+							Computed:    true,
+						},
+						"mac_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "MAC address of the client",
+							Computed:    true,
+						},
+						"subnet": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Subnet of the client network",
+							Computed:    true,
+						},
+						"lease_time": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Lease time of the IP address, in seconds",
+							Computed:    true,
+						},
+						"start_time": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Start time of the lease",
+							Computed:    true,
+						},
+						"expire_time": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Expire time of the lease",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setDhcpLeasesInSchema(d *schema.ResourceData, leases []manager.DhcpLeasePerIp) error {
+	var leaseList []map[string]interface{}
+	for _, lease := range leases {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = lease.IpAddress
+		elem["mac_address"] = lease.MacAddress
+		elem["subnet"] = lease.Subnet
+		elem["lease_time"] = lease.LeaseTime
+		elem["start_time"] = lease.StartTime
+		elem["expire_time"] = lease.ExpireTime
+		leaseList = append(leaseList, elem)
+	}
+	return d.Set("lease", leaseList)
+}
+
+func dataSourceNsxtDhcpServerLeasesRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	serverID := d.Get("logical_dhcp_server_id").(string)
+
+	localVarOptionals := make(map[string]interface{})
+	if address := d.Get("address").(string); address != "" {
+		localVarOptionals["address"] = address
+	}
+	if poolID := d.Get("pool_id").(string); poolID != "" {
+		localVarOptionals["poolId"] = poolID
+	}
+	if source := d.Get("source").(string); source != "" {
+		localVarOptionals["source"] = source
+	}
+	leases, resp, err := nsxClient.ServicesApi.GetDhcpLeaseInfo(nsxClient.Context, serverID, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Logical DHCP server %s was not found", serverID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading leases of logical DHCP server %s: %v", serverID, err)
+	}
+
+	d.SetId(serverID)
+	d.Set("timestamp", leases.Timestamp)
+	err = setDhcpLeasesInSchema(d, leases.Leases)
+	if err != nil {
+		return fmt.Errorf("Error during DHCP leases set in schema: %v", err)
+	}
+
+	return nil
+}
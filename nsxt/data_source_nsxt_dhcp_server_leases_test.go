@@ -0,0 +1,39 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtDhcpServerLeases_basic(t *testing.T) {
+	edgeClusterName := getEdgeClusterName()
+	testResourceName := "data.nsxt_dhcp_server_leases.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDhcpServerLeasesReadTemplate(edgeClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "timestamp"),
+					resource.TestCheckResourceAttr(testResourceName, "lease.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXDhcpServerLeasesReadTemplate(edgeClusterName string) string {
+	return testAccNSXCreateDhcpIPPoolPrerequisites(edgeClusterName) + `
+
+data "nsxt_dhcp_server_leases" "test" {
+  logical_dhcp_server_id = "${nsxt_logical_dhcp_server.DS.id}"
+  address                = "1.1.1.20-1.1.1.40"
+  source                 = "realtime"
+}`
+}
@@ -0,0 +1,144 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtDhcpServerStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtDhcpServerStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_dhcp_server_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the logical DHCP server",
+				Required:    true,
+			},
+			"service_status": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Status of the DHCP service (UP, DOWN, ERROR or NO_STANDBY)",
+				Computed:    true,
+			},
+			"active_node": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the active transport node",
+				Computed:    true,
+			},
+			"stand_by_node": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the stand by transport node, if any",
+				Computed:    true,
+			},
+			"error_message": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Error message, if any",
+				Computed:    true,
+			},
+			"acks":      getDhcpPacketCountSchema("Total number of DHCP ACK packets"),
+			"declines":  getDhcpPacketCountSchema("Total number of DHCP DECLINE packets"),
+			"discovers": getDhcpPacketCountSchema("Total number of DHCP DISCOVER packets"),
+			"errors":    getDhcpPacketCountSchema("Total number of DHCP errors"),
+			"informs":   getDhcpPacketCountSchema("Total number of DHCP INFORM packets"),
+			"nacks":     getDhcpPacketCountSchema("Total number of DHCP NACK packets"),
+			"offers":    getDhcpPacketCountSchema("Total number of DHCP OFFER packets"),
+			"releases":  getDhcpPacketCountSchema("Total number of DHCP RELEASE packets"),
+			"requests":  getDhcpPacketCountSchema("Total number of DHCP REQUEST packets"),
+			"ip_pool_usage": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Usage statistics of the DHCP server IP pools",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pool_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "ID of the DHCP server IP pool",
+							Computed:    true,
+						},
+						"pool_size": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Size of the IP pool",
+							Computed:    true,
+						},
+						"allocated_number": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Number of allocated addresses (approximate)",
+							Computed:    true,
+						},
+						"allocated_percentage": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Percentage of allocated addresses (approximate)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getDhcpPacketCountSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: description,
+		Computed:    true,
+	}
+}
+
+func setDhcpIPPoolUsageInSchema(d *schema.ResourceData, poolStats []manager.DhcpIpPoolUsage) error {
+	var usageList []map[string]interface{}
+	for _, usage := range poolStats {
+		elem := make(map[string]interface{})
+		elem["pool_id"] = usage.DhcpIpPoolId
+		elem["pool_size"] = usage.PoolSize
+		elem["allocated_number"] = usage.AllocatedNumber
+		elem["allocated_percentage"] = usage.AllocatedPercentage
+		usageList = append(usageList, elem)
+	}
+	return d.Set("ip_pool_usage", usageList)
+}
+
+func dataSourceNsxtDhcpServerStatusRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	serverID := d.Get("logical_dhcp_server_id").(string)
+
+	status, resp, err := nsxClient.ServicesApi.GetDhcpStatus(nsxClient.Context, serverID)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Logical DHCP server %s was not found", serverID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading status of logical DHCP server %s: %v", serverID, err)
+	}
+
+	stats, _, err := nsxClient.ServicesApi.GetDhcpStatistics(nsxClient.Context, serverID)
+	if err != nil {
+		return fmt.Errorf("Error while reading statistics of logical DHCP server %s: %v", serverID, err)
+	}
+
+	d.SetId(serverID)
+	d.Set("service_status", status.ServiceStatus)
+	d.Set("active_node", status.ActiveNode)
+	d.Set("stand_by_node", status.StandByNode)
+	d.Set("error_message", status.ErrorMessage)
+	d.Set("acks", stats.Acks)
+	d.Set("declines", stats.Declines)
+	d.Set("discovers", stats.Discovers)
+	d.Set("errors", stats.Errors)
+	d.Set("informs", stats.Informs)
+	d.Set("nacks", stats.Nacks)
+	d.Set("offers", stats.Offers)
+	d.Set("releases", stats.Releases)
+	d.Set("requests", stats.Requests)
+	err = setDhcpIPPoolUsageInSchema(d, stats.IpPoolStats)
+	if err != nil {
+		return fmt.Errorf("Error during DHCP IP pool usage set in schema: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtDhcpServerStatus_basic(t *testing.T) {
+	edgeClusterName := getEdgeClusterName()
+	testResourceName := "data.nsxt_dhcp_server_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDhcpServerStatusReadTemplate(edgeClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "service_status"),
+					resource.TestCheckResourceAttrSet(testResourceName, "active_node"),
+					resource.TestCheckResourceAttrSet(testResourceName, "discovers"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_pool_usage.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_pool_usage.0.pool_size", "21"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXDhcpServerStatusReadTemplate(edgeClusterName string) string {
+	return testAccNSXCreateDhcpIPPoolPrerequisites(edgeClusterName) + `
+
+resource "nsxt_dhcp_server_ip_pool" "test" {
+  logical_dhcp_server_id = "${nsxt_logical_dhcp_server.DS.id}"
+
+  ip_range {
+    start = "1.1.1.20"
+    end   = "1.1.1.40"
+  }
+}
+
+data "nsxt_dhcp_server_status" "test" {
+  logical_dhcp_server_id = "${nsxt_logical_dhcp_server.DS.id}"
+  depends_on             = ["nsxt_dhcp_server_ip_pool.test"]
+}`
+}
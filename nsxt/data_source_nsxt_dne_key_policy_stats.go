@@ -0,0 +1,89 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"net/http"
+)
+
+func dataSourceNsxtDneKeyPolicyStats() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtDneKeyPolicyStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"key_policy_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the DNE key policy",
+				Required:    true,
+			},
+			"source": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Data source type of the statistics (realtime or cached)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(firewallStatsSourceValues, false),
+			},
+			"bytes_encrypted": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of bytes encrypted with the key policy",
+				Computed:    true,
+			},
+			"bytes_decrypted": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of bytes decrypted with the key policy",
+				Computed:    true,
+			},
+			"bytes_dropped": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of bytes dropped by the key policy",
+				Computed:    true,
+			},
+			"packets_encrypted": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of packets encrypted with the key policy",
+				Computed:    true,
+			},
+			"packets_decrypted": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of packets decrypted with the key policy",
+				Computed:    true,
+			},
+			"packets_dropped": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of packets dropped by the key policy",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtDneKeyPolicyStatsRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	keyPolicyID := d.Get("key_policy_id").(string)
+
+	localVarOptionals := make(map[string]interface{})
+	if source := d.Get("source").(string); source != "" {
+		localVarOptionals["source"] = source
+	}
+	stats, resp, err := nsxClient.ServicesApi.GetDneKeyPolicyStats(nsxClient.Context, keyPolicyID, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("DNE key policy %s was not found", keyPolicyID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading statistics of DNE key policy %s: %v", keyPolicyID, err)
+	}
+
+	d.SetId(keyPolicyID)
+	d.Set("bytes_encrypted", stats.BytesEncrypted)
+	d.Set("bytes_decrypted", stats.BytesDecrypted)
+	d.Set("bytes_dropped", stats.BytesDropped)
+	d.Set("packets_encrypted", stats.PacketsEncrypted)
+	d.Set("packets_decrypted", stats.PacketsDecrypted)
+	d.Set("packets_dropped", stats.PacketsDropped)
+
+	return nil
+}
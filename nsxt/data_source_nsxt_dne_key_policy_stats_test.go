@@ -0,0 +1,38 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtDneKeyPolicyStats_basic(t *testing.T) {
+	keyPolicyName := "terraform_test_dne_key_policy_stats"
+	testResourceName := "data.nsxt_dne_key_policy_stats.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneKeyPolicyStatsReadTemplate(keyPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "bytes_encrypted"),
+					resource.TestCheckResourceAttrSet(testResourceName, "packets_encrypted"),
+					resource.TestCheckResourceAttrSet(testResourceName, "packets_dropped"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXDneKeyPolicyStatsReadTemplate(name string) string {
+	return testAccNSXDneKeyPolicyCreateTemplate(name) + `
+
+data "nsxt_dne_key_policy_stats" "test" {
+  key_policy_id = "${nsxt_dne_key_policy.test.id}"
+}`
+}
@@ -0,0 +1,83 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"net/http"
+)
+
+func dataSourceNsxtDneRuleStats() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtDneRuleStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"section_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the DNE section containing the rule",
+				Required:    true,
+			},
+			"rule_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the DNE rule",
+				Required:    true,
+			},
+			"source": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Data source type of the statistics (realtime or cached)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(firewallStatsSourceValues, false),
+			},
+			"bytes_in": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of incoming bytes processed by the rule",
+				Computed:    true,
+			},
+			"bytes_out": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of outgoing bytes processed by the rule",
+				Computed:    true,
+			},
+			"packets_in": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of incoming packets processed by the rule",
+				Computed:    true,
+			},
+			"packets_out": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of outgoing packets processed by the rule",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtDneRuleStatsRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	sectionID := d.Get("section_id").(string)
+	ruleID := d.Get("rule_id").(string)
+
+	localVarOptionals := make(map[string]interface{})
+	if source := d.Get("source").(string); source != "" {
+		localVarOptionals["source"] = source
+	}
+	stats, resp, err := nsxClient.ServicesApi.GetDneRuleStats(nsxClient.Context, sectionID, ruleID, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("DNE rule %s was not found in section %s", ruleID, sectionID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading statistics of DNE rule %s: %v", ruleID, err)
+	}
+
+	d.SetId(ruleID)
+	d.Set("bytes_in", stats.BytesIn)
+	d.Set("bytes_out", stats.BytesOut)
+	d.Set("packets_in", stats.PacketsIn)
+	d.Set("packets_out", stats.PacketsOut)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtDneRuleStats_basic(t *testing.T) {
+	sectionName := "terraform_test_dne_rule_stats"
+	testResourceName := "data.nsxt_dne_rule_stats.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneRuleStatsReadTemplate(sectionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "bytes_in"),
+					resource.TestCheckResourceAttrSet(testResourceName, "bytes_out"),
+					resource.TestCheckResourceAttrSet(testResourceName, "packets_in"),
+					resource.TestCheckResourceAttrSet(testResourceName, "packets_out"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXDneRuleStatsReadTemplate(name string) string {
+	return testAccNSXDneSectionCreateTemplate(name, "rule1") + `
+
+data "nsxt_dne_rule_stats" "test" {
+  section_id = "${nsxt_dne_section.test.id}"
+  rule_id    = "${nsxt_dne_section.test.rule.0.id}"
+  source     = "realtime"
+}`
+}
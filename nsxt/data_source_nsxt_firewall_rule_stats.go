@@ -0,0 +1,123 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"strconv"
+)
+
+var firewallStatsSourceValues = []string{"realtime", "cached"}
+
+func dataSourceNsxtFirewallRuleStats() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtFirewallRuleStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"section_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the firewall section containing the rule",
+				Required:    true,
+			},
+			"rule_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the firewall rule",
+				Required:    true,
+			},
+			"source": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Data source type of the statistics (realtime or cached)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(firewallStatsSourceValues, false),
+			},
+			"packet_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Aggregated number of packets processed by the rule",
+				Computed:    true,
+			},
+			"byte_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Aggregated number of bytes processed by the rule",
+				Computed:    true,
+			},
+			"session_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Aggregated number of sessions processed by the rule",
+				Computed:    true,
+			},
+			"state":            getRealizationStateSchema(),
+			"failure_code":     getRealizationFailureCodeSchema(),
+			"failure_message":  getRealizationFailureMessageSchema(),
+			"revision_desired": getRealizationRevisionDesiredSchema(),
+			"details":          getRealizationStateDetailsSchema(),
+		},
+	}
+}
+
+func parseFirewallStatsCounter(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	counter, err := strconv.ParseInt(value, 10, 64)
+	return int(counter), err
+}
+
+func dataSourceNsxtFirewallRuleStatsRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	sectionID := d.Get("section_id").(string)
+	ruleID := d.Get("rule_id").(string)
+
+	localVarOptionals := make(map[string]interface{})
+	if source := d.Get("source").(string); source != "" {
+		localVarOptionals["source"] = source
+	}
+	stats, resp, err := nsxClient.ServicesApi.GetFirewallStats(nsxClient.Context, sectionID, ruleID, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Firewall rule %s was not found in section %s", ruleID, sectionID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading statistics of firewall rule %s: %v", ruleID, err)
+	}
+
+	packetCount, err := parseFirewallStatsCounter(stats.PacketCount)
+	if err != nil {
+		return fmt.Errorf("Error while parsing packet count of firewall rule %s: %v", ruleID, err)
+	}
+	byteCount, err := parseFirewallStatsCounter(stats.ByteCount)
+	if err != nil {
+		return fmt.Errorf("Error while parsing byte count of firewall rule %s: %v", ruleID, err)
+	}
+	sessionCount, err := parseFirewallStatsCounter(stats.SessionCount)
+	if err != nil {
+		return fmt.Errorf("Error while parsing session count of firewall rule %s: %v", ruleID, err)
+	}
+
+	// Getting the realization state requires another api call
+	state, resp, err := nsxClient.ServicesApi.GetRuleState(nsxClient.Context, ruleID, make(map[string]interface{}))
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Firewall rule %s was not found", ruleID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading state of firewall rule %s: %v", ruleID, err)
+	}
+
+	d.SetId(ruleID)
+	d.Set("packet_count", packetCount)
+	d.Set("byte_count", byteCount)
+	d.Set("session_count", sessionCount)
+	d.Set("state", state.State)
+	d.Set("failure_code", state.FailureCode)
+	d.Set("failure_message", state.FailureMessage)
+	d.Set("revision_desired", state.RevisionDesired)
+	err = setRealizationStateDetailsInSchema(d, state.Details)
+	if err != nil {
+		return fmt.Errorf("Error during firewall rule state details set in schema: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtFirewallRuleStats_basic(t *testing.T) {
+	sectionName := "terraform_test_firewall_rule_stats"
+	testResourceName := "data.nsxt_firewall_rule_stats.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXFirewallRuleStatsReadTemplate(sectionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "packet_count"),
+					resource.TestCheckResourceAttrSet(testResourceName, "byte_count"),
+					resource.TestCheckResourceAttrSet(testResourceName, "session_count"),
+					resource.TestCheckResourceAttrSet(testResourceName, "state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXFirewallRuleStatsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_firewall_section" "test" {
+  display_name = "%s"
+  section_type = "LAYER3"
+  stateful     = true
+
+  rule {
+    display_name = "rule1"
+    action       = "ALLOW"
+    ip_protocol  = "IPV4"
+    direction    = "IN_OUT"
+  }
+}
+
+data "nsxt_firewall_rule_stats" "test" {
+  section_id = "${nsxt_firewall_section.test.id}"
+  rule_id    = "${nsxt_firewall_section.test.rule.0.id}"
+  source     = "realtime"
+}`, name)
+}
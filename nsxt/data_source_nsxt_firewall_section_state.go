@@ -0,0 +1,147 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtFirewallSectionState() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtFirewallSectionStateRead,
+
+		Schema: map[string]*schema.Schema{
+			"section_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the firewall section",
+				Required:    true,
+			},
+			"state":            getRealizationStateSchema(),
+			"failure_code":     getRealizationFailureCodeSchema(),
+			"failure_message":  getRealizationFailureMessageSchema(),
+			"revision_desired": getRealizationRevisionDesiredSchema(),
+			"details":          getRealizationStateDetailsSchema(),
+		},
+	}
+}
+
+// utilities to define & handle realization state of firewall objects
+func getRealizationStateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Realization state of the desired configuration",
+		Computed:    true,
+	}
+}
+
+func getRealizationFailureCodeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Error code in case of realization failure",
+		Computed:    true,
+	}
+}
+
+func getRealizationFailureMessageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Error message in case of realization failure",
+		Computed:    true,
+	}
+}
+
+func getRealizationRevisionDesiredSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Revision number of the desired state",
+		Computed:    true,
+	}
+}
+
+func getRealizationStateDetailsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Realization state on the various sub systems (transport nodes)",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"sub_system_id": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Identifier of backing resource on sub system",
+					Computed:    true,
+				},
+				"sub_system_type": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Type of backing resource on sub system",
+					Computed:    true,
+				},
+				"sub_system_address": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "URI of backing resource on sub system",
+					Computed:    true,
+				},
+				"state": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "State of configuration on this sub system",
+					Computed:    true,
+				},
+				"failure_code": &schema.Schema{
+					Type:        schema.TypeInt,
+					Description: "Error code in case of failure",
+					Computed:    true,
+				},
+				"failure_message": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Error message in case of failure",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func setRealizationStateDetailsInSchema(d *schema.ResourceData, details []manager.ConfigurationStateElement) error {
+	var detailsList []map[string]interface{}
+	for _, detail := range details {
+		elem := make(map[string]interface{})
+		elem["sub_system_id"] = detail.SubSystemId
+		elem["sub_system_type"] = detail.SubSystemType
+		elem["sub_system_address"] = detail.SubSystemAddress
+		elem["state"] = detail.State
+		elem["failure_code"] = detail.FailureCode
+		elem["failure_message"] = detail.FailureMessage
+		detailsList = append(detailsList, elem)
+	}
+	return d.Set("details", detailsList)
+}
+
+func dataSourceNsxtFirewallSectionStateRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	sectionID := d.Get("section_id").(string)
+
+	localVarOptionals := make(map[string]interface{})
+	state, resp, err := nsxClient.ServicesApi.GetSectionState(nsxClient.Context, sectionID, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Firewall section %s was not found", sectionID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading state of firewall section %s: %v", sectionID, err)
+	}
+
+	d.SetId(sectionID)
+	d.Set("state", state.State)
+	d.Set("failure_code", state.FailureCode)
+	d.Set("failure_message", state.FailureMessage)
+	d.Set("revision_desired", state.RevisionDesired)
+	err = setRealizationStateDetailsInSchema(d, state.Details)
+	if err != nil {
+		return fmt.Errorf("Error during firewall section state details set in schema: %v", err)
+	}
+
+	return nil
+}
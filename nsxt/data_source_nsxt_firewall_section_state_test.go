@@ -0,0 +1,43 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtFirewallSectionState_basic(t *testing.T) {
+	sectionName := "terraform_test_firewall_section_state"
+	testResourceName := "data.nsxt_firewall_section_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXFirewallSectionStateReadTemplate(sectionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "state"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision_desired"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXFirewallSectionStateReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_firewall_section" "test" {
+  display_name = "%s"
+  section_type = "LAYER3"
+  stateful     = true
+}
+
+data "nsxt_firewall_section_state" "test" {
+  section_id = "${nsxt_firewall_section.test.id}"
+}`, name)
+}
@@ -0,0 +1,87 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+)
+
+// The license usage report covers the whole NSX deployment
+const licenseUsageID = "license_usage"
+
+func dataSourceNsxtLicenseUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLicenseUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"feature_usage": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Usage of the licensed features",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"feature": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Name of the feature",
+							Computed:    true,
+						},
+						"capacity_usage": &schema.Schema{
+							Type:        schema.TypeList,
+							Description: "Usage of the feature per capacity type",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"capacity_type": &schema.Schema{
+										Type:        schema.TypeString,
+										Description: "License metric",
+										Computed:    true,
+									},
+									"usage_count": &schema.Schema{
+										Type:        schema.TypeInt,
+										Description: "Number of units of the capacity type in use",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtLicenseUsageRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+
+	report, _, err := nsxClient.LicensingApi.GetLicenseUsageReport(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while reading license usage report: %v", err)
+	}
+
+	var featureUsageList []map[string]interface{}
+	for _, featureUsage := range report.FeatureUsageInfo {
+		elem := make(map[string]interface{})
+		elem["feature"] = featureUsage.Feature
+		var capacityUsageList []map[string]interface{}
+		for _, capacityUsage := range featureUsage.CapacityUsage {
+			capacityElem := make(map[string]interface{})
+			capacityElem["capacity_type"] = capacityUsage.CapacityType
+			capacityElem["usage_count"] = capacityUsage.UsageCount
+			capacityUsageList = append(capacityUsageList, capacityElem)
+		}
+		elem["capacity_usage"] = capacityUsageList
+		featureUsageList = append(featureUsageList, elem)
+	}
+	err = d.Set("feature_usage", featureUsageList)
+	if err != nil {
+		return fmt.Errorf("Error during license usage report set in schema: %v", err)
+	}
+
+	d.SetId(licenseUsageID)
+
+	return nil
+}
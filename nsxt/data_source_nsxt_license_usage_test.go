@@ -0,0 +1,33 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtLicenseUsage_basic(t *testing.T) {
+	testResourceName := "data.nsxt_license_usage.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLicenseUsageReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "id", "license_usage"),
+					resource.TestCheckResourceAttrSet(testResourceName, "feature_usage.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXLicenseUsageReadTemplate() string {
+	return `
+data "nsxt_license_usage" "test" {
+}`
+}
@@ -0,0 +1,142 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtLogicalPortStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLogicalPortStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_port_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the logical port",
+				Required:    true,
+			},
+			"source": getTableSourceSchema(),
+			"operational_status": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Operational status of the logical port",
+				Computed:    true,
+			},
+			"transport_node_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "IDs of the transport nodes on which the logical port is located",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"last_update_timestamp": getLastUpdateTimestampSchema(),
+			"rx_bytes":              getDataCounterSchema("Received bytes"),
+			"rx_packets":            getDataCounterSchema("Received packets"),
+			"tx_bytes":              getDataCounterSchema("Transmitted bytes"),
+			"tx_packets":            getDataCounterSchema("Transmitted packets"),
+			"macs_learned": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of MAC addresses learned",
+				Computed:    true,
+			},
+			"mac": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "MAC addresses of the logical port",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "MAC address",
+							Computed:    true,
+						},
+						"mac_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Type of the MAC address",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setLogicalPortMacEntriesInSchema(d *schema.ResourceData, entries []manager.LogicalPortMacTableEntry) error {
+	var macList []map[string]interface{}
+	for _, entry := range entries {
+		elem := make(map[string]interface{})
+		elem["mac_address"] = entry.MacAddress
+		elem["mac_type"] = entry.MacType
+		macList = append(macList, elem)
+	}
+	return d.Set("mac", macList)
+}
+
+func getLogicalPortMacEntries(nsxClient *api.APIClient, portID string, localVarOptionals map[string]interface{}) ([]manager.LogicalPortMacTableEntry, error) {
+	var entries []manager.LogicalPortMacTableEntry
+	_, err := readAllPages(localVarOptionals, func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalPortMacTable(nsxClient.Context, portID, localVarOptionals)
+		entries = append(entries, result.Results...)
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func dataSourceNsxtLogicalPortStatusRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	portID := d.Get("logical_port_id").(string)
+
+	state, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalPortState(nsxClient.Context, portID)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Logical port %s was not found", portID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading state of logical port %s: %v", portID, err)
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	if source := d.Get("source").(string); source != "" {
+		localVarOptionals["source"] = source
+	}
+	status, _, err := nsxClient.LogicalSwitchingApi.GetLogicalPortOperationalStatus(nsxClient.Context, portID, localVarOptionals)
+	if err != nil {
+		return fmt.Errorf("Error while reading operational status of logical port %s: %v", portID, err)
+	}
+
+	statistics, _, err := nsxClient.LogicalSwitchingApi.GetLogicalPortStatistics(nsxClient.Context, portID, localVarOptionals)
+	if err != nil {
+		return fmt.Errorf("Error while reading statistics of logical port %s: %v", portID, err)
+	}
+
+	macOptionals := make(map[string]interface{})
+	if source := d.Get("source").(string); source != "" {
+		macOptionals["source"] = source
+	}
+	macEntries, err := getLogicalPortMacEntries(nsxClient, portID, macOptionals)
+	if err != nil {
+		return fmt.Errorf("Error while reading MAC table of logical port %s: %v", portID, err)
+	}
+
+	d.SetId(portID)
+	d.Set("operational_status", status.Status)
+	d.Set("transport_node_ids", state.TransportNodeIds)
+	d.Set("last_update_timestamp", statistics.LastUpdateTimestamp)
+	err = setTrafficCountersInSchema(d, statistics.RxBytes, statistics.RxPackets, statistics.TxBytes, statistics.TxPackets, statistics.MacLearning)
+	if err != nil {
+		return fmt.Errorf("Error during logical port statistics set in schema: %v", err)
+	}
+	err = setLogicalPortMacEntriesInSchema(d, macEntries)
+	if err != nil {
+		return fmt.Errorf("Error during logical port MAC table set in schema: %v", err)
+	}
+
+	return nil
+}
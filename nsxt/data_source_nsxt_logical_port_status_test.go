@@ -0,0 +1,39 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtLogicalPortStatus_basic(t *testing.T) {
+	transportZoneName := getOverlayTransportZoneName()
+	testResourceName := "data.nsxt_logical_port_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLogicalPortStatusReadTemplate(transportZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "id", "nsxt_logical_port.test", "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "operational_status"),
+					resource.TestCheckResourceAttr(testResourceName, "rx_packets.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "mac.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXLogicalPortStatusReadTemplate(transportZoneName string) string {
+	return testAccNSXLogicalPortCreateTemplate("test-nsx-port-status", transportZoneName) + `
+
+data "nsxt_logical_port_status" "test" {
+  logical_port_id = "${nsxt_logical_port.test.id}"
+  source          = "realtime"
+}`
+}
@@ -0,0 +1,249 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"log"
+	"net/http"
+	"time"
+)
+
+var bgpNeighborConnectionStateValues = []string{"IDLE", "CONNECT", "ACTIVE", "OPEN_SENT", "OPEN_CONFIRM", "ESTABLISHED"}
+
+func dataSourceNsxtLogicalRouterBgpNeighborStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLogicalRouterBgpNeighborStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_router_id": getLogicalRouterIDForTableSchema(),
+			"transport_node_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Only return the status of the BGP neighbors on this edge transport node",
+				Optional:    true,
+			},
+			"wait_for_state": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Wait until all the BGP neighbors reach this connection state",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(bgpNeighborConnectionStateValues, false),
+			},
+			"timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Maximum time to wait for the BGP neighbors to reach the wait_for_state state (in seconds)",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"last_update_timestamp": getLastUpdateTimestampSchema(),
+			"neighbor": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Status of the BGP neighbors of the logical router, per edge transport node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"neighbor_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "IP address of the BGP neighbor",
+							Computed:    true,
+						},
+						"source_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "IP address of the local logical router port",
+							Computed:    true,
+						},
+						"remote_as_number": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "AS number of the BGP neighbor",
+							Computed:    true,
+						},
+						"neighbor_router_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Router ID of the BGP neighbor",
+							Computed:    true,
+						},
+						"connection_state": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Current state of the BGP session",
+							Computed:    true,
+						},
+						"time_since_established": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Time since the connection was established (in milliseconds)",
+							Computed:    true,
+						},
+						"established_connection_count": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Count of connections established",
+							Computed:    true,
+						},
+						"connection_drop_count": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Count of connection drops",
+							Computed:    true,
+						},
+						"messages_received": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Count of messages received from the neighbor",
+							Computed:    true,
+						},
+						"messages_sent": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Count of messages sent to the neighbor",
+							Computed:    true,
+						},
+						"total_in_prefix_count": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Count of prefixes received from the neighbor",
+							Computed:    true,
+						},
+						"total_out_prefix_count": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Count of prefixes sent to the neighbor",
+							Computed:    true,
+						},
+						"transport_node_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "ID of the edge transport node of the BGP session",
+							Computed:    true,
+						},
+						"transport_node_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Name of the edge transport node of the BGP session",
+							Computed:    true,
+						},
+						"lr_component_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "ID of the logical router component (service router or distributed router)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setBgpNeighborsStatusInSchema(d *schema.ResourceData, neighbors []manager.BgpNeighborStatus) error {
+	var neighborList []map[string]interface{}
+	for _, neighbor := range neighbors {
+		elem := make(map[string]interface{})
+		elem["neighbor_address"] = neighbor.NeighborAddress
+		elem["source_address"] = neighbor.SourceAddress
+		elem["remote_as_number"] = neighbor.RemoteAsNumber
+		elem["neighbor_router_id"] = neighbor.NeighborRouterId
+		elem["connection_state"] = neighbor.ConnectionState
+		elem["time_since_established"] = neighbor.TimeSinceEstablished
+		elem["established_connection_count"] = neighbor.EstablishedConnectionCount
+		elem["connection_drop_count"] = neighbor.ConnectionDropCount
+		elem["messages_received"] = neighbor.MessagesReceived
+		elem["messages_sent"] = neighbor.MessagesSent
+		elem["total_in_prefix_count"] = neighbor.TotalInPrefixCount
+		elem["total_out_prefix_count"] = neighbor.TotalOutPrefixCount
+		if neighbor.TransportNode != nil {
+			elem["transport_node_id"] = neighbor.TransportNode.TargetId
+			elem["transport_node_name"] = neighbor.TransportNode.TargetDisplayName
+		}
+		elem["lr_component_id"] = neighbor.LrComponentId
+		neighborList = append(neighborList, elem)
+	}
+	return d.Set("neighbor", neighborList)
+}
+
+func getBgpNeighborsStatus(nsxClient *api.APIClient, routerID string, localVarOptionals map[string]interface{}) ([]manager.BgpNeighborStatus, int64, *http.Response, error) {
+	var neighbors []manager.BgpNeighborStatus
+	var lastUpdateTimestamp int64
+	resp, err := readAllPages(localVarOptionals, func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.LogicalRoutingAndServicesApi.GetBgpNeighborsStatus(nsxClient.Context, routerID, localVarOptionals)
+		neighbors = append(neighbors, result.Results...)
+		lastUpdateTimestamp = result.LastUpdateTimestamp
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, 0, resp, err
+	}
+	return neighbors, lastUpdateTimestamp, resp, nil
+}
+
+func dataSourceNsxtLogicalRouterBgpNeighborStatusRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	routerID := d.Get("logical_router_id").(string)
+	nodeID := d.Get("transport_node_id").(string)
+	waitForState := d.Get("wait_for_state").(string)
+
+	var neighbors []manager.BgpNeighborStatus
+	var lastUpdate int64
+	readStatus := func() error {
+		localVarOptionals := make(map[string]interface{})
+		if nodeID != "" {
+			localVarOptionals["transportNodeId"] = nodeID
+		}
+		var resp *http.Response
+		var err error
+		neighbors, lastUpdate, resp, err = getBgpNeighborsStatus(nsxClient, routerID, localVarOptionals)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Logical router %s was not found", routerID)
+		}
+		if err != nil {
+			return fmt.Errorf("Error while reading BGP neighbors status of logical router %s: %v", routerID, err)
+		}
+		return nil
+	}
+
+	if waitForState == "" {
+		err := readStatus()
+		if err != nil {
+			return err
+		}
+	} else {
+		// Poll until all the neighbors reach the requested state
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"pending"},
+			Target:  []string{"reached"},
+			Refresh: func() (interface{}, string, error) {
+				err := readStatus()
+				if err != nil {
+					return nil, "", err
+				}
+
+				reached := 0
+				for _, neighbor := range neighbors {
+					if neighbor.ConnectionState == waitForState {
+						reached++
+					}
+				}
+				log.Printf("[DEBUG] %d of %d BGP neighbors of logical router %s are %s", reached, len(neighbors), routerID, waitForState)
+				if len(neighbors) > 0 && reached == len(neighbors) {
+					return neighbors, "reached", nil
+				}
+				return neighbors, "pending", nil
+			},
+			Timeout:    time.Duration(d.Get("timeout").(int)) * time.Second,
+			MinTimeout: 5 * time.Second,
+			Delay:      1 * time.Second,
+		}
+		_, err := stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error while waiting for BGP neighbors of logical router %s to be %s: %v", routerID, waitForState, err)
+		}
+	}
+
+	if nodeID != "" {
+		d.SetId(fmt.Sprintf("%s/%s", routerID, nodeID))
+	} else {
+		d.SetId(routerID)
+	}
+	d.Set("last_update_timestamp", lastUpdate)
+	err := setBgpNeighborsStatusInSchema(d, neighbors)
+	if err != nil {
+		return fmt.Errorf("Error during BGP neighbors status set in schema: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDataSourceNsxtLogicalRouterBgpNeighborStatus_basic(t *testing.T) {
+	routerName := getTier0RouterName()
+	testResourceName := "data.nsxt_logical_router_bgp_neighbor_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLogicalRouterBgpNeighborStatusReadTemplate(routerName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "neighbor.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceNsxtLogicalRouterBgpNeighborStatus_waitTimeout(t *testing.T) {
+	routerName := getTier0RouterName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// No BGP session is expected to stay in the CONNECT state
+				Config:      testAccNSXLogicalRouterBgpNeighborStatusReadTemplate(routerName, "CONNECT"),
+				ExpectError: regexp.MustCompile(`Error while waiting for BGP neighbors`),
+			},
+		},
+	})
+}
+
+func testAccNSXLogicalRouterBgpNeighborStatusReadTemplate(routerName string, waitForState string) string {
+	return fmt.Sprintf(`
+data "nsxt_logical_tier0_router" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_logical_router_bgp_neighbor_status" "test" {
+  logical_router_id = "${data.nsxt_logical_tier0_router.test.id}"
+  wait_for_state    = "%s"
+  timeout           = 10
+}`, routerName, waitForState)
+}
@@ -0,0 +1,62 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtLogicalRouterForwardingTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLogicalRouterForwardingTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_router_id": getLogicalRouterIDForTableSchema(),
+			"transport_node_id": getTransportNodeIDForTableSchema(),
+			"network_prefix":    getNetworkPrefixForTableSchema(),
+			"route_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Only return the routes of this type",
+				Optional:    true,
+			},
+			"source":                getTableSourceSchema(),
+			"last_update_timestamp": getLastUpdateTimestampSchema(),
+			"route":                 getLogicalRouterRouteEntriesSchema("Routes of the forwarding table (FIB)"),
+		},
+	}
+}
+
+func dataSourceNsxtLogicalRouterForwardingTableRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	routerID := d.Get("logical_router_id").(string)
+	nodeID := d.Get("transport_node_id").(string)
+
+	localVarOptionals := getLogicalRouterTableOptionals(d)
+	if networkPrefix := d.Get("network_prefix").(string); networkPrefix != "" {
+		localVarOptionals["networkPrefix"] = networkPrefix
+	}
+	getter := func(localVarOptionals map[string]interface{}) (manager.LogicalRouterRouteTable, *http.Response, error) {
+		return nsxClient.LogicalRoutingAndServicesApi.GetLogicalRouterForwardingTable(nsxClient.Context, routerID, nodeID, localVarOptionals)
+	}
+	routes, lastUpdate, resp, err := getLogicalRouterRouteEntries(getter, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Logical router %s was not found", routerID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading forwarding table of logical router %s on transport node %s: %v", routerID, nodeID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", routerID, nodeID))
+	d.Set("last_update_timestamp", lastUpdate)
+	err = setLogicalRouterRouteEntriesInSchema(d, routes, d.Get("route_type").(string))
+	if err != nil {
+		return fmt.Errorf("Error during logical router forwarding table set in schema: %v", err)
+	}
+
+	return nil
+}
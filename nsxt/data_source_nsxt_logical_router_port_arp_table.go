@@ -0,0 +1,107 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtLogicalRouterPortArpTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLogicalRouterPortArpTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_router_port_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the logical router port",
+				Required:    true,
+			},
+			"transport_node_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the transport node on which the table is read",
+				Optional:    true,
+			},
+			"source":                getTableSourceSchema(),
+			"last_update_timestamp": getLastUpdateTimestampSchema(),
+			"entry": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Entries of the ARP table",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "IP address",
+							Computed:    true,
+						},
+						"mac_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "MAC address",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setLogicalRouterPortArpEntriesInSchema(d *schema.ResourceData, entries []manager.LogicalRouterPortArpEntry) error {
+	var entryList []map[string]interface{}
+	for _, entry := range entries {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = entry.Ip
+		elem["mac_address"] = entry.MacAddress
+		entryList = append(entryList, elem)
+	}
+	return d.Set("entry", entryList)
+}
+
+func getLogicalRouterPortArpEntries(nsxClient *api.APIClient, portID string, localVarOptionals map[string]interface{}) ([]manager.LogicalRouterPortArpEntry, int64, *http.Response, error) {
+	var entries []manager.LogicalRouterPortArpEntry
+	var lastUpdateTimestamp int64
+	resp, err := readAllPages(localVarOptionals, func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.LogicalRoutingAndServicesApi.GetLogicalRouterPortArpTable(nsxClient.Context, portID, localVarOptionals)
+		entries = append(entries, result.Results...)
+		lastUpdateTimestamp = result.LastUpdateTimestamp
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, 0, resp, err
+	}
+	return entries, lastUpdateTimestamp, resp, nil
+}
+
+func dataSourceNsxtLogicalRouterPortArpTableRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	portID := d.Get("logical_router_port_id").(string)
+
+	localVarOptionals := make(map[string]interface{})
+	if nodeID := d.Get("transport_node_id").(string); nodeID != "" {
+		localVarOptionals["transportNodeId"] = nodeID
+	}
+	if source := d.Get("source").(string); source != "" {
+		localVarOptionals["source"] = source
+	}
+	entries, lastUpdate, resp, err := getLogicalRouterPortArpEntries(nsxClient, portID, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Logical router port %s was not found", portID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading ARP table of logical router port %s: %v", portID, err)
+	}
+
+	d.SetId(portID)
+	d.Set("last_update_timestamp", lastUpdate)
+	err = setLogicalRouterPortArpEntriesInSchema(d, entries)
+	if err != nil {
+		return fmt.Errorf("Error during logical router port ARP table set in schema: %v", err)
+	}
+
+	return nil
+}
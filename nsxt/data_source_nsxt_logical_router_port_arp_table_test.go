@@ -0,0 +1,43 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtLogicalRouterPortArpTable_basic(t *testing.T) {
+	transportZoneName := getOverlayTransportZoneName()
+	edgeClusterName := getEdgeClusterName()
+	nodeID := getTestEdgeNodeID()
+	testResourceName := "data.nsxt_logical_router_port_arp_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_NODE_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLogicalRouterPortArpTableReadTemplate(transportZoneName, edgeClusterName, nodeID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "id", "nsxt_logical_router_downlink_port.test", "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "entry.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXLogicalRouterPortArpTableReadTemplate(transportZoneName string, edgeClusterName string, nodeID string) string {
+	return testAccNSXLogicalRouterDownlinkPortCreateTemplate("arp-table-port", transportZoneName, edgeClusterName) + fmt.Sprintf(`
+
+data "nsxt_logical_router_port_arp_table" "test" {
+  logical_router_port_id = "${nsxt_logical_router_downlink_port.test.id}"
+  transport_node_id      = "%s"
+}`, nodeID)
+}
@@ -0,0 +1,57 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtLogicalRouterRouteTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLogicalRouterRouteTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_router_id": getLogicalRouterIDForTableSchema(),
+			"transport_node_id": getTransportNodeIDForTableSchema(),
+			"route_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Only return the routes of this type",
+				Optional:    true,
+			},
+			"source":                getTableSourceSchema(),
+			"last_update_timestamp": getLastUpdateTimestampSchema(),
+			"route":                 getLogicalRouterRouteEntriesSchema("Routes of the route table"),
+		},
+	}
+}
+
+func dataSourceNsxtLogicalRouterRouteTableRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	routerID := d.Get("logical_router_id").(string)
+	nodeID := d.Get("transport_node_id").(string)
+
+	getter := func(localVarOptionals map[string]interface{}) (manager.LogicalRouterRouteTable, *http.Response, error) {
+		return nsxClient.LogicalRoutingAndServicesApi.GetLogicalRouterRouteTable(nsxClient.Context, routerID, nodeID, localVarOptionals)
+	}
+	routes, lastUpdate, resp, err := getLogicalRouterRouteEntries(getter, getLogicalRouterTableOptionals(d))
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Logical router %s was not found", routerID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading route table of logical router %s on transport node %s: %v", routerID, nodeID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", routerID, nodeID))
+	d.Set("last_update_timestamp", lastUpdate)
+	err = setLogicalRouterRouteEntriesInSchema(d, routes, d.Get("route_type").(string))
+	if err != nil {
+		return fmt.Errorf("Error during logical router route table set in schema: %v", err)
+	}
+
+	return nil
+}
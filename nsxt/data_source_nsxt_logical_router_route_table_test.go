@@ -0,0 +1,45 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtLogicalRouterRouteTable_basic(t *testing.T) {
+	routerName := getTier0RouterName()
+	nodeID := getTestEdgeNodeID()
+	testResourceName := "data.nsxt_logical_router_route_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_NODE_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLogicalRouterRouteTableReadTemplate(routerName, nodeID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "route.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXLogicalRouterRouteTableReadTemplate(routerName string, nodeID string) string {
+	return fmt.Sprintf(`
+data "nsxt_logical_tier0_router" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_logical_router_route_table" "test" {
+  logical_router_id = "${data.nsxt_logical_tier0_router.test.id}"
+  transport_node_id = "%s"
+}`, routerName, nodeID)
+}
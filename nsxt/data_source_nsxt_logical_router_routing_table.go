@@ -0,0 +1,199 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtLogicalRouterRoutingTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLogicalRouterRoutingTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_router_id": getLogicalRouterIDForTableSchema(),
+			"transport_node_id": getTransportNodeIDForTableSchema(),
+			"network_prefix":    getNetworkPrefixForTableSchema(),
+			"route_source": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Only return the routes learned from this source, such as BGP, STATIC or CONNECTED",
+				Optional:    true,
+			},
+			"source":                getTableSourceSchema(),
+			"last_update_timestamp": getLastUpdateTimestampSchema(),
+			"route":                 getLogicalRouterRouteEntriesSchema("Routes of the routing table (RIB)"),
+		},
+	}
+}
+
+func getLogicalRouterIDForTableSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the logical router",
+		Required:    true,
+	}
+}
+
+func getTransportNodeIDForTableSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the edge transport node on which the table is read",
+		Required:    true,
+	}
+}
+
+func getNetworkPrefixForTableSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Only return the routes matching this IPv4 CIDR block",
+		Optional:     true,
+		ValidateFunc: validateCidr(),
+	}
+}
+
+func getTableSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Data source type of the table (realtime or cached)",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(firewallStatsSourceValues, false),
+	}
+}
+
+func getLastUpdateTimestampSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Timestamp when the data was last updated, in milliseconds since epoch",
+		Computed:    true,
+	}
+}
+
+func getLogicalRouterRouteEntriesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"network": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "CIDR network address",
+					Computed:    true,
+				},
+				"next_hop": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "IP address of the next hop",
+					Computed:    true,
+				},
+				"route_type": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Route type",
+					Computed:    true,
+				},
+				"admin_distance": &schema.Schema{
+					Type:        schema.TypeInt,
+					Description: "Admin distance of the next hop",
+					Computed:    true,
+				},
+				"logical_router_port_id": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "ID of the logical router port used as the next hop",
+					Computed:    true,
+				},
+				"lr_component_id": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "ID of the logical router component (service router or distributed router)",
+					Computed:    true,
+				},
+				"lr_component_type": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Type of the logical router component (service router or distributed router)",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func setLogicalRouterRouteEntriesInSchema(d *schema.ResourceData, routes []manager.LogicalRouterRouteEntry, routeType string) error {
+	var routeList []map[string]interface{}
+	for _, route := range routes {
+		if routeType != "" && route.RouteType != routeType {
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["network"] = route.Network
+		elem["next_hop"] = route.NextHop
+		elem["route_type"] = route.RouteType
+		elem["admin_distance"] = route.AdminDistance
+		elem["logical_router_port_id"] = route.LogicalRouterPortId
+		elem["lr_component_id"] = route.LrComponentId
+		elem["lr_component_type"] = route.LrComponentType
+		routeList = append(routeList, elem)
+	}
+	return d.Set("route", routeList)
+}
+
+type logicalRouterRouteTableGetter func(localVarOptionals map[string]interface{}) (manager.LogicalRouterRouteTable, *http.Response, error)
+
+func getLogicalRouterRouteEntries(getter logicalRouterRouteTableGetter, localVarOptionals map[string]interface{}) ([]manager.LogicalRouterRouteEntry, int64, *http.Response, error) {
+	var routes []manager.LogicalRouterRouteEntry
+	var lastUpdateTimestamp int64
+	resp, err := readAllPages(localVarOptionals, func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := getter(localVarOptionals)
+		routes = append(routes, result.Results...)
+		lastUpdateTimestamp = result.LastUpdateTimestamp
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, 0, resp, err
+	}
+	return routes, lastUpdateTimestamp, resp, nil
+}
+
+func getLogicalRouterTableOptionals(d *schema.ResourceData) map[string]interface{} {
+	localVarOptionals := make(map[string]interface{})
+	if source := d.Get("source").(string); source != "" {
+		localVarOptionals["source"] = source
+	}
+	return localVarOptionals
+}
+
+func dataSourceNsxtLogicalRouterRoutingTableRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	routerID := d.Get("logical_router_id").(string)
+	nodeID := d.Get("transport_node_id").(string)
+
+	localVarOptionals := getLogicalRouterTableOptionals(d)
+	if networkPrefix := d.Get("network_prefix").(string); networkPrefix != "" {
+		localVarOptionals["networkPrefix"] = networkPrefix
+	}
+	if routeSource := d.Get("route_source").(string); routeSource != "" {
+		localVarOptionals["routeSource"] = routeSource
+	}
+	getter := func(localVarOptionals map[string]interface{}) (manager.LogicalRouterRouteTable, *http.Response, error) {
+		return nsxClient.LogicalRoutingAndServicesApi.GetLogicalRouterRoutingTable(nsxClient.Context, routerID, nodeID, localVarOptionals)
+	}
+	routes, lastUpdate, resp, err := getLogicalRouterRouteEntries(getter, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Logical router %s was not found", routerID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading routing table of logical router %s on transport node %s: %v", routerID, nodeID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", routerID, nodeID))
+	d.Set("last_update_timestamp", lastUpdate)
+	err = setLogicalRouterRouteEntriesInSchema(d, routes, "")
+	if err != nil {
+		return fmt.Errorf("Error during logical router routing table set in schema: %v", err)
+	}
+
+	return nil
+}
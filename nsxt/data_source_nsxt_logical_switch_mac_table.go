@@ -0,0 +1,181 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtLogicalSwitchMacTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLogicalSwitchMacTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_switch_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the logical switch",
+				Required:    true,
+			},
+			"transport_node_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the transport node on which the tables are read",
+				Optional:    true,
+			},
+			"source":                getTableSourceSchema(),
+			"last_update_timestamp": getLastUpdateTimestampSchema(),
+			"mac": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Entries of the MAC table",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "MAC address",
+							Computed:    true,
+						},
+						"vtep_ip": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "IP address of the virtual tunnel endpoint behind which the MAC address is located",
+							Computed:    true,
+						},
+						"vtep_mac_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "MAC address of the virtual tunnel endpoint",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"vtep": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Entries of the VTEP table",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"segment_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Segment ID",
+							Computed:    true,
+						},
+						"vtep_ip": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "IP address of the virtual tunnel endpoint",
+							Computed:    true,
+						},
+						"vtep_label": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Label of the virtual tunnel endpoint",
+							Computed:    true,
+						},
+						"vtep_mac_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "MAC address of the virtual tunnel endpoint",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setLogicalSwitchMacEntriesInSchema(d *schema.ResourceData, entries []manager.MacTableEntry) error {
+	var macList []map[string]interface{}
+	for _, entry := range entries {
+		elem := make(map[string]interface{})
+		elem["mac_address"] = entry.MacAddress
+		elem["vtep_ip"] = entry.VtepIp
+		elem["vtep_mac_address"] = entry.VtepMacAddress
+		macList = append(macList, elem)
+	}
+	return d.Set("mac", macList)
+}
+
+func setLogicalSwitchVtepEntriesInSchema(d *schema.ResourceData, entries []manager.VtepTableEntry) error {
+	var vtepList []map[string]interface{}
+	for _, entry := range entries {
+		elem := make(map[string]interface{})
+		elem["segment_id"] = entry.SegmentId
+		elem["vtep_ip"] = entry.VtepIp
+		elem["vtep_label"] = entry.VtepLabel
+		elem["vtep_mac_address"] = entry.VtepMacAddress
+		vtepList = append(vtepList, elem)
+	}
+	return d.Set("vtep", vtepList)
+}
+
+func getLogicalSwitchMacEntries(nsxClient *api.APIClient, switchID string, localVarOptionals map[string]interface{}) ([]manager.MacTableEntry, int64, *http.Response, error) {
+	var entries []manager.MacTableEntry
+	var lastUpdateTimestamp int64
+	resp, err := readAllPages(localVarOptionals, func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalSwitchMacTable(nsxClient.Context, switchID, localVarOptionals)
+		entries = append(entries, result.Results...)
+		lastUpdateTimestamp = result.LastUpdateTimestamp
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, 0, resp, err
+	}
+	return entries, lastUpdateTimestamp, resp, nil
+}
+
+func getLogicalSwitchVtepEntries(nsxClient *api.APIClient, switchID string, localVarOptionals map[string]interface{}) ([]manager.VtepTableEntry, error) {
+	var entries []manager.VtepTableEntry
+	_, err := readAllPages(localVarOptionals, func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalSwitchVtepTable(nsxClient.Context, switchID, localVarOptionals)
+		entries = append(entries, result.Results...)
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func getLogicalSwitchTableOptionals(d *schema.ResourceData) map[string]interface{} {
+	localVarOptionals := make(map[string]interface{})
+	if nodeID := d.Get("transport_node_id").(string); nodeID != "" {
+		localVarOptionals["transportNodeId"] = nodeID
+	}
+	if source := d.Get("source").(string); source != "" {
+		localVarOptionals["source"] = source
+	}
+	return localVarOptionals
+}
+
+func dataSourceNsxtLogicalSwitchMacTableRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	switchID := d.Get("logical_switch_id").(string)
+
+	macEntries, lastUpdate, resp, err := getLogicalSwitchMacEntries(nsxClient, switchID, getLogicalSwitchTableOptionals(d))
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Logical switch %s was not found", switchID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading MAC table of logical switch %s: %v", switchID, err)
+	}
+
+	vtepEntries, err := getLogicalSwitchVtepEntries(nsxClient, switchID, getLogicalSwitchTableOptionals(d))
+	if err != nil {
+		return fmt.Errorf("Error while reading VTEP table of logical switch %s: %v", switchID, err)
+	}
+
+	d.SetId(switchID)
+	d.Set("last_update_timestamp", lastUpdate)
+	err = setLogicalSwitchMacEntriesInSchema(d, macEntries)
+	if err != nil {
+		return fmt.Errorf("Error during logical switch MAC table set in schema: %v", err)
+	}
+	err = setLogicalSwitchVtepEntriesInSchema(d, vtepEntries)
+	if err != nil {
+		return fmt.Errorf("Error during logical switch VTEP table set in schema: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtLogicalSwitchMacTable_basic(t *testing.T) {
+	transportZoneName := getOverlayTransportZoneName()
+	testResourceName := "data.nsxt_logical_switch_mac_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLogicalSwitchMacTableReadTemplate(transportZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "id", "nsxt_logical_switch.test", "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "mac.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "vtep.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXLogicalSwitchMacTableReadTemplate(transportZoneName string) string {
+	return testAccNSXLogicalSwitchCreateForPort(transportZoneName) + `
+
+data "nsxt_logical_switch_mac_table" "test" {
+  logical_switch_id = "${nsxt_logical_switch.test.id}"
+}`
+}
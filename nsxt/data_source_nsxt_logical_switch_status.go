@@ -0,0 +1,151 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtLogicalSwitchStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLogicalSwitchStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"logical_switch_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the logical switch",
+				Required:    true,
+			},
+			"source": getTableSourceSchema(),
+			"state": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Realization state of the logical switch",
+				Computed:    true,
+			},
+			"failure_message": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Error message in case of realization failure",
+				Computed:    true,
+			},
+			"num_logical_ports": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of logical ports on the logical switch",
+				Computed:    true,
+			},
+			"last_update_timestamp": getLastUpdateTimestampSchema(),
+			"rx_bytes":              getDataCounterSchema("Received bytes"),
+			"rx_packets":            getDataCounterSchema("Received packets"),
+			"tx_bytes":              getDataCounterSchema("Transmitted bytes"),
+			"tx_packets":            getDataCounterSchema("Transmitted packets"),
+			"macs_learned": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of MAC addresses learned",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getDataCounterSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"total": &schema.Schema{
+					Type:        schema.TypeInt,
+					Description: "Total number",
+					Computed:    true,
+				},
+				"dropped": &schema.Schema{
+					Type:        schema.TypeInt,
+					Description: "Number dropped",
+					Computed:    true,
+				},
+				"multicast_broadcast": &schema.Schema{
+					Type:        schema.TypeInt,
+					Description: "Number of multicast and broadcast",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func setDataCounterInSchema(d *schema.ResourceData, counter *manager.DataCounter, schemaAttrName string) error {
+	var counterList []map[string]interface{}
+	if counter != nil {
+		elem := make(map[string]interface{})
+		elem["total"] = counter.Total
+		elem["dropped"] = counter.Dropped
+		elem["multicast_broadcast"] = counter.MulticastBroadcast
+		counterList = append(counterList, elem)
+	}
+	return d.Set(schemaAttrName, counterList)
+}
+
+func setTrafficCountersInSchema(d *schema.ResourceData, rxBytes *manager.DataCounter, rxPackets *manager.DataCounter, txBytes *manager.DataCounter, txPackets *manager.DataCounter, macLearning *manager.MacLearningCounters) error {
+	counters := map[string]*manager.DataCounter{
+		"rx_bytes":   rxBytes,
+		"rx_packets": rxPackets,
+		"tx_bytes":   txBytes,
+		"tx_packets": txPackets,
+	}
+	for attrName, counter := range counters {
+		err := setDataCounterInSchema(d, counter, attrName)
+		if err != nil {
+			return err
+		}
+	}
+	if macLearning != nil {
+		d.Set("macs_learned", macLearning.MacsLearned)
+	} else {
+		d.Set("macs_learned", 0)
+	}
+	return nil
+}
+
+func dataSourceNsxtLogicalSwitchStatusRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	switchID := d.Get("logical_switch_id").(string)
+
+	state, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalSwitchState(nsxClient.Context, switchID)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Logical switch %s was not found", switchID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading state of logical switch %s: %v", switchID, err)
+	}
+
+	status, _, err := nsxClient.LogicalSwitchingApi.GetLogicalSwitchStatus(nsxClient.Context, switchID)
+	if err != nil {
+		return fmt.Errorf("Error while reading status of logical switch %s: %v", switchID, err)
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	if source := d.Get("source").(string); source != "" {
+		localVarOptionals["source"] = source
+	}
+	statistics, _, err := nsxClient.LogicalSwitchingApi.GetLogicalSwitchStatistics(nsxClient.Context, switchID, localVarOptionals)
+	if err != nil {
+		return fmt.Errorf("Error while reading statistics of logical switch %s: %v", switchID, err)
+	}
+
+	d.SetId(switchID)
+	d.Set("state", state.State)
+	d.Set("failure_message", state.FailureMessage)
+	d.Set("num_logical_ports", status.NumLogicalPorts)
+	d.Set("last_update_timestamp", statistics.LastUpdateTimestamp)
+	err = setTrafficCountersInSchema(d, statistics.RxBytes, statistics.RxPackets, statistics.TxBytes, statistics.TxPackets, statistics.MacLearning)
+	if err != nil {
+		return fmt.Errorf("Error during logical switch statistics set in schema: %v", err)
+	}
+
+	return nil
+}
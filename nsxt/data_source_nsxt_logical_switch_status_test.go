@@ -0,0 +1,39 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtLogicalSwitchStatus_basic(t *testing.T) {
+	transportZoneName := getOverlayTransportZoneName()
+	testResourceName := "data.nsxt_logical_switch_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLogicalSwitchStatusReadTemplate(transportZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "id", "nsxt_logical_switch.test", "id"),
+					resource.TestCheckResourceAttr(testResourceName, "state", "success"),
+					resource.TestCheckResourceAttr(testResourceName, "num_logical_ports", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rx_bytes.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tx_packets.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXLogicalSwitchStatusReadTemplate(transportZoneName string) string {
+	return testAccNSXLogicalPortCreateTemplate("test-nsx-port-status", transportZoneName) + `
+
+data "nsxt_logical_switch_status" "test" {
+  logical_switch_id = "${nsxt_logical_port.test.logical_switch_id}"
+}`
+}
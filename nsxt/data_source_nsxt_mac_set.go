@@ -0,0 +1,99 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtMacSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtMacSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Unique ID of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"mac_addresses": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "Set of MAC addresses",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtMacSetRead(d *schema.ResourceData, m interface{}) error {
+	// Read MAC set by name or id
+	nsxClient := m.(*api.APIClient)
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	var obj manager.MacSet
+	if objID != "" {
+		// Get by id
+		objGet, resp, err := nsxClient.GroupingObjectsApi.ReadMACSet(nsxClient.Context, objID)
+
+		if err != nil {
+			return fmt.Errorf("Error while reading MAC set %s: %v", objID, err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("MAC set %s was not found", objID)
+		}
+		obj = objGet
+	} else if objName != "" {
+		// Get by full name
+		var objList []manager.MacSet
+		_, err := readAllPages(make(map[string]interface{}), func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+			result, resp, err := nsxClient.GroupingObjectsApi.ListMACSets(nsxClient.Context, localVarOptionals)
+			objList = append(objList, result.Results...)
+			return result.Cursor, resp, err
+		})
+		if err != nil {
+			return fmt.Errorf("Error while reading MAC sets: %v", err)
+		}
+		// go over the list to find the correct one
+		found := false
+		for _, objInList := range objList {
+			if objInList.DisplayName == objName {
+				if found {
+					return fmt.Errorf("Found multiple MAC sets with name '%s'", objName)
+				}
+				obj = objInList
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("MAC set '%s' was not found out of %d sets", objName, len(objList))
+		}
+	} else {
+		return fmt.Errorf("Error obtaining MAC set ID or name during read")
+	}
+
+	d.SetId(obj.Id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("mac_addresses", obj.MacAddresses)
+
+	return nil
+}
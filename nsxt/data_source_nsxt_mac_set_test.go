@@ -0,0 +1,107 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+	"testing"
+)
+
+func TestAccDataSourceNsxtMacSet_basic(t *testing.T) {
+	setName := "terraform_test_mac_set"
+	testResourceName := "data.nsxt_mac_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccDataSourceNsxtMacSetDeleteByName(setName)
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccDataSourceNsxtMacSetCreate(setName); err != nil {
+						panic(err)
+					}
+				},
+				Config: testAccNSXMacSetReadTemplate(setName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", setName),
+					resource.TestCheckResourceAttr(testResourceName, "description", setName),
+					resource.TestCheckResourceAttr(testResourceName, "mac_addresses.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXNoMacSetTemplate(),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNsxtMacSetCreate(setName string) error {
+	nsxClient, err := testAccGetClient()
+	if err != nil {
+		return fmt.Errorf("Error during test client initialization: %v", err)
+	}
+
+	macSet := manager.MacSet{
+		Description:  setName,
+		DisplayName:  setName,
+		MacAddresses: []string{"00:11:22:33:44:55"},
+	}
+
+	macSet, responseCode, err := nsxClient.GroupingObjectsApi.CreateMACSet(nsxClient.Context, macSet)
+	if err != nil {
+		return fmt.Errorf("Error during macSet creation: %v", err)
+	}
+
+	if responseCode.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during macSet creation: %v", responseCode.StatusCode)
+	}
+	return nil
+}
+
+func testAccDataSourceNsxtMacSetDeleteByName(setName string) error {
+	nsxClient, err := testAccGetClient()
+	if err != nil {
+		return fmt.Errorf("Error during test client initialization: %v", err)
+	}
+
+	// Find the object by name
+	objList, _, err := nsxClient.GroupingObjectsApi.ListMACSets(nsxClient.Context, nil)
+	if err != nil {
+		return fmt.Errorf("Error while reading MAC sets: %v", err)
+	}
+	// go over the list to find the correct one
+	for _, objInList := range objList.Results {
+		if objInList.DisplayName == setName {
+			localVarOptionals := make(map[string]interface{})
+			responseCode, err := nsxClient.GroupingObjectsApi.DeleteMACSet(nsxClient.Context, objInList.Id, localVarOptionals)
+			if err != nil {
+				return fmt.Errorf("Error during macSet deletion: %v", err)
+			}
+
+			if responseCode.StatusCode != http.StatusOK {
+				return fmt.Errorf("Unexpected status returned during macSet deletion: %v", responseCode.StatusCode)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("Error while deleting MAC set '%s': group not found", setName)
+}
+
+func testAccNSXMacSetReadTemplate(setName string) string {
+	return fmt.Sprintf(`
+data "nsxt_mac_set" "test" {
+  display_name = "%s"
+}`, setName)
+}
+
+func testAccNSXNoMacSetTemplate() string {
+	return fmt.Sprintf(` `)
+}
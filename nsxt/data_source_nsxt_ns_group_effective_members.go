@@ -0,0 +1,207 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"net/http"
+)
+
+func dataSourceNsxtNsGroupEffectiveMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtNsGroupEffectiveMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"ns_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the NS group",
+				Required:    true,
+			},
+			"ip_addresses": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Effective IP address members of the NS group",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+			"logical_port":   getEffectiveMemberResourcesSchema("Effective logical port members of the NS group"),
+			"logical_switch": getEffectiveMemberResourcesSchema("Effective logical switch members of the NS group"),
+			"virtual_machine": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Effective virtual machine members of the NS group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Current external id of this virtual machine in the system",
+							Computed:    true,
+						},
+						"display_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The display name of this virtual machine",
+							Computed:    true,
+						},
+						"host_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "ID of the host in which this virtual machine exists",
+							Computed:    true,
+						},
+						"power_state": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Current power state of this virtual machine in the system",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getEffectiveMemberResourcesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"target_id": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Identifier of the NSX resource",
+					Computed:    true,
+				},
+				"target_display_name": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Display name of the NSX resource",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func setEffectiveMemberResourcesInSchema(d *schema.ResourceData, members []common.ResourceReference, schemaAttrName string) error {
+	var memberList []map[string]interface{}
+	for _, member := range members {
+		elem := make(map[string]interface{})
+		elem["target_id"] = member.TargetId
+		elem["target_display_name"] = member.TargetDisplayName
+		memberList = append(memberList, elem)
+	}
+	return d.Set(schemaAttrName, memberList)
+}
+
+func setEffectiveVirtualMachinesInSchema(d *schema.ResourceData, vms []manager.VirtualMachine) error {
+	var vmList []map[string]interface{}
+	for _, vm := range vms {
+		elem := make(map[string]interface{})
+		elem["external_id"] = vm.ExternalId
+		elem["display_name"] = vm.DisplayName
+		elem["host_id"] = vm.HostId
+		elem["power_state"] = vm.PowerState
+		vmList = append(vmList, elem)
+	}
+	return d.Set("virtual_machine", vmList)
+}
+
+func getNsGroupEffectiveIPAddresses(nsxClient *api.APIClient, nsGroupID string) ([]string, *http.Response, error) {
+	var members []string
+	resp, err := readAllPages(make(map[string]interface{}), func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.GroupingObjectsApi.GetEffectiveIPAddressMembers(nsxClient.Context, nsGroupID, localVarOptionals)
+		members = append(members, result.Results...)
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	return members, resp, nil
+}
+
+func getNsGroupEffectiveLogicalPorts(nsxClient *api.APIClient, nsGroupID string) ([]common.ResourceReference, *http.Response, error) {
+	var members []common.ResourceReference
+	resp, err := readAllPages(make(map[string]interface{}), func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.GroupingObjectsApi.GetEffectiveLogicalPortMembers(nsxClient.Context, nsGroupID, localVarOptionals)
+		members = append(members, result.Results...)
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	return members, resp, nil
+}
+
+func getNsGroupEffectiveLogicalSwitches(nsxClient *api.APIClient, nsGroupID string) ([]common.ResourceReference, *http.Response, error) {
+	var members []common.ResourceReference
+	resp, err := readAllPages(make(map[string]interface{}), func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.GroupingObjectsApi.GetEffectiveLogicalSwitchMembers(nsxClient.Context, nsGroupID, localVarOptionals)
+		members = append(members, result.Results...)
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	return members, resp, nil
+}
+
+func getNsGroupEffectiveVirtualMachines(nsxClient *api.APIClient, nsGroupID string) ([]manager.VirtualMachine, *http.Response, error) {
+	var members []manager.VirtualMachine
+	resp, err := readAllPages(make(map[string]interface{}), func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.GroupingObjectsApi.GetEffectiveVirtualMachineMembers(nsxClient.Context, nsGroupID, localVarOptionals)
+		members = append(members, result.Results...)
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	return members, resp, nil
+}
+
+func dataSourceNsxtNsGroupEffectiveMembersRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	nsGroupID := d.Get("ns_group_id").(string)
+
+	ipAddresses, resp, err := getNsGroupEffectiveIPAddresses(nsxClient, nsGroupID)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("NS group %s was not found", nsGroupID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading effective IP address members of NS group %s: %v", nsGroupID, err)
+	}
+
+	logicalPorts, _, err := getNsGroupEffectiveLogicalPorts(nsxClient, nsGroupID)
+	if err != nil {
+		return fmt.Errorf("Error while reading effective logical port members of NS group %s: %v", nsGroupID, err)
+	}
+
+	logicalSwitches, _, err := getNsGroupEffectiveLogicalSwitches(nsxClient, nsGroupID)
+	if err != nil {
+		return fmt.Errorf("Error while reading effective logical switch members of NS group %s: %v", nsGroupID, err)
+	}
+
+	virtualMachines, _, err := getNsGroupEffectiveVirtualMachines(nsxClient, nsGroupID)
+	if err != nil {
+		return fmt.Errorf("Error while reading effective virtual machine members of NS group %s: %v", nsGroupID, err)
+	}
+
+	d.SetId(nsGroupID)
+	d.Set("ip_addresses", ipAddresses)
+	err = setEffectiveMemberResourcesInSchema(d, logicalPorts, "logical_port")
+	if err != nil {
+		return fmt.Errorf("Error during NS group effective logical ports set in schema: %v", err)
+	}
+	err = setEffectiveMemberResourcesInSchema(d, logicalSwitches, "logical_switch")
+	if err != nil {
+		return fmt.Errorf("Error during NS group effective logical switches set in schema: %v", err)
+	}
+	err = setEffectiveVirtualMachinesInSchema(d, virtualMachines)
+	if err != nil {
+		return fmt.Errorf("Error during NS group effective virtual machines set in schema: %v", err)
+	}
+
+	return nil
+}
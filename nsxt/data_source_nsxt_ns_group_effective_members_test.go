@@ -0,0 +1,53 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNsxtNsGroupEffectiveMembers_basic(t *testing.T) {
+	groupName := "terraform_test_ns_group_effective_members"
+	testResourceName := "data.nsxt_ns_group_effective_members.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXNsGroupEffectiveMembersReadTemplate(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "logical_port.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "logical_switch.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "virtual_machine.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXNsGroupEffectiveMembersReadTemplate(groupName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_ip_set" "test" {
+  display_name = "%s"
+  ip_addresses = ["1.1.1.1", "2.2.2.2"]
+}
+
+resource "nsxt_ns_group" "test" {
+  display_name = "%s"
+
+  member {
+    target_type = "IPSet"
+    value       = "${nsxt_ip_set.test.id}"
+  }
+}
+
+data "nsxt_ns_group_effective_members" "test" {
+  ns_group_id = "${nsxt_ns_group.test.id}"
+}`, groupName, groupName)
+}
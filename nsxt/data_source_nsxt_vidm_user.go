@@ -0,0 +1,82 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/aaa"
+	"net/http"
+)
+
+func dataSourceNsxtVidmUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtVidmUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Name of the user or group to search for in vIDM",
+				Required:    true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Whether to search for a vIDM user or a vIDM group",
+				Optional:     true,
+				Default:      "remote_user",
+				ValidateFunc: validation.StringInSlice(roleBindingTypeValues, false),
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Display name of the user or group",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtVidmUserRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	name := d.Get("name").(string)
+	vidmType := d.Get("type").(string)
+
+	var results []aaa.VidmInfo
+	resp, err := readAllPages(make(map[string]interface{}), func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		var resultList aaa.VidmInfoListResult
+		var resp *http.Response
+		var err error
+		if vidmType == "remote_group" {
+			resultList, resp, err = nsxClient.AaaApi.GetGroupVidmSearchResult(nsxClient.Context, name, localVarOptionals)
+		} else {
+			resultList, resp, err = nsxClient.AaaApi.GetUserVidmSearchResult(nsxClient.Context, name, localVarOptionals)
+		}
+		results = append(results, resultList.Results...)
+		return resultList.Cursor, resp, err
+	})
+	if err != nil {
+		return fmt.Errorf("Error while searching vIDM for %s %s: %v", vidmType, name, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status returned while searching vIDM for %s %s: %v", vidmType, name, resp.StatusCode)
+	}
+
+	// The search matches on prefixes, so only accept an exact match of the name
+	var match *aaa.VidmInfo
+	for i, result := range results {
+		if result.Name == name {
+			match = &results[i]
+			break
+		}
+	}
+	if match == nil {
+		return fmt.Errorf("vIDM %s %s was not found", vidmType, name)
+	}
+
+	d.SetId(match.Name)
+	d.Set("display_name", match.DisplayName)
+
+	return nil
+}
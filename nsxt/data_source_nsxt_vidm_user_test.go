@@ -0,0 +1,41 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceNsxtVidmUser_basic(t *testing.T) {
+	userName := os.Getenv("NSXT_TEST_VIDM_USER")
+	testResourceName := "data.nsxt_vidm_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VIDM_USER")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXVidmUserReadTemplate(userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "id", userName),
+					resource.TestCheckResourceAttr(testResourceName, "name", userName),
+					resource.TestCheckResourceAttrSet(testResourceName, "display_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXVidmUserReadTemplate(userName string) string {
+	return fmt.Sprintf(`
+data "nsxt_vidm_user" "test" {
+  name = "%s"
+}`, userName)
+}
@@ -83,28 +83,56 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"nsxt_transport_zone":       dataSourceNsxtTransportZone(),
-			"nsxt_switching_profile":    dataSourceNsxtSwitchingProfile(),
-			"nsxt_logical_tier0_router": dataSourceNsxtLogicalTier0Router(),
-			"nsxt_logical_tier1_router": dataSourceNsxtLogicalTier1Router(),
-			"nsxt_mac_pool":             dataSourceNsxtMacPool(),
-			"nsxt_ns_group":             dataSourceNsxtNsGroup(),
-			"nsxt_ns_service":           dataSourceNsxtNsService(),
-			"nsxt_edge_cluster":         dataSourceNsxtEdgeCluster(),
-			"nsxt_certificate":          dataSourceNsxtCertificate(),
+			"nsxt_transport_zone":                     dataSourceNsxtTransportZone(),
+			"nsxt_switching_profile":                  dataSourceNsxtSwitchingProfile(),
+			"nsxt_logical_tier0_router":               dataSourceNsxtLogicalTier0Router(),
+			"nsxt_logical_tier1_router":               dataSourceNsxtLogicalTier1Router(),
+			"nsxt_logical_router_routing_table":       dataSourceNsxtLogicalRouterRoutingTable(),
+			"nsxt_logical_router_forwarding_table":    dataSourceNsxtLogicalRouterForwardingTable(),
+			"nsxt_logical_router_route_table":         dataSourceNsxtLogicalRouterRouteTable(),
+			"nsxt_logical_router_bgp_neighbor_status": dataSourceNsxtLogicalRouterBgpNeighborStatus(),
+			"nsxt_logical_router_port_arp_table":      dataSourceNsxtLogicalRouterPortArpTable(),
+			"nsxt_logical_switch_status":              dataSourceNsxtLogicalSwitchStatus(),
+			"nsxt_logical_switch_mac_table":           dataSourceNsxtLogicalSwitchMacTable(),
+			"nsxt_logical_port_status":                dataSourceNsxtLogicalPortStatus(),
+			"nsxt_mac_pool":                           dataSourceNsxtMacPool(),
+			"nsxt_ns_group":                           dataSourceNsxtNsGroup(),
+			"nsxt_ns_service":                         dataSourceNsxtNsService(),
+			"nsxt_edge_cluster":                       dataSourceNsxtEdgeCluster(),
+			"nsxt_certificate":                        dataSourceNsxtCertificate(),
+			"nsxt_firewall_section_state":             dataSourceNsxtFirewallSectionState(),
+			"nsxt_firewall_rule_stats":                dataSourceNsxtFirewallRuleStats(),
+			"nsxt_mac_set":                            dataSourceNsxtMacSet(),
+			"nsxt_ns_group_effective_members":         dataSourceNsxtNsGroupEffectiveMembers(),
+			"nsxt_dne_rule_stats":                     dataSourceNsxtDneRuleStats(),
+			"nsxt_dne_key_policy_stats":               dataSourceNsxtDneKeyPolicyStats(),
+			"nsxt_app_discovery_session":              dataSourceNsxtAppDiscoverySession(),
+			"nsxt_dhcp_server_leases":                 dataSourceNsxtDhcpServerLeases(),
+			"nsxt_dhcp_server_status":                 dataSourceNsxtDhcpServerStatus(),
+			"nsxt_license_usage":                      dataSourceNsxtLicenseUsage(),
+			"nsxt_vidm_user":                          dataSourceNsxtVidmUser(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"nsxt_backup_config":                           resourceNsxtBackupConfig(),
+			"nsxt_eula_acceptance":                         resourceNsxtEulaAcceptance(),
+			"nsxt_license":                                 resourceNsxtLicense(),
+			"nsxt_role_binding":                            resourceNsxtRoleBinding(),
 			"nsxt_dhcp_relay_profile":                      resourceNsxtDhcpRelayProfile(),
 			"nsxt_dhcp_relay_service":                      resourceNsxtDhcpRelayService(),
 			"nsxt_dhcp_server_profile":                     resourceNsxtDhcpServerProfile(),
 			"nsxt_logical_dhcp_server":                     resourceNsxtLogicalDhcpServer(),
 			"nsxt_dhcp_server_ip_pool":                     resourceNsxtDhcpServerIPPool(),
+			"nsxt_dhcp_server_static_binding":              resourceNsxtDhcpServerStaticBinding(),
 			"nsxt_logical_switch":                          resourceNsxtLogicalSwitch(),
 			"nsxt_logical_dhcp_port":                       resourceNsxtLogicalDhcpPort(),
 			"nsxt_logical_port":                            resourceNsxtLogicalPort(),
+			"nsxt_metadata_proxy":                          resourceNsxtMetadataProxy(),
 			"nsxt_logical_tier0_router":                    resourceNsxtLogicalTier0Router(),
 			"nsxt_logical_tier1_router":                    resourceNsxtLogicalTier1Router(),
+			"nsxt_manager_syslog_exporter":                 resourceNsxtManagerSyslogExporter(),
+			"nsxt_manager_ntp_config":                      resourceNsxtManagerNtpConfig(),
+			"nsxt_manager_dns_config":                      resourceNsxtManagerDNSConfig(),
 			"nsxt_logical_router_centralized_service_port": resourceNsxtLogicalRouterCentralizedServicePort(),
 			"nsxt_logical_router_downlink_port":            resourceNsxtLogicalRouterDownLinkPort(),
 			"nsxt_logical_router_link_port_on_tier0":       resourceNsxtLogicalRouterLinkPortOnTier0(),
@@ -122,12 +150,23 @@ func Provider() terraform.ResourceProvider {
 			"nsxt_ip_protocol_ns_service":                  resourceNsxtIPProtocolNsService(),
 			"nsxt_ns_service_group":                        resourceNsxtNsServiceGroup(),
 			"nsxt_ns_group":                                resourceNsxtNsGroup(),
+			"nsxt_ns_group_member":                         resourceNsxtNsGroupMember(),
 			"nsxt_firewall_section":                        resourceNsxtFirewallSection(),
+			"nsxt_dne_key_policy":                          resourceNsxtDneKeyPolicy(),
+			"nsxt_dne_section":                             resourceNsxtDneSection(),
+			"nsxt_dne_global_config":                       resourceNsxtDneGlobalConfig(),
+			"nsxt_app_profile":                             resourceNsxtAppProfile(),
+			"nsxt_app_discovery_session":                   resourceNsxtAppDiscoverySession(),
+			"nsxt_certificate":                             resourceNsxtCertificate(),
+			"nsxt_crl":                                     resourceNsxtCrl(),
 			"nsxt_nat_rule":                                resourceNsxtNatRule(),
 			"nsxt_ip_block":                                resourceNsxtIPBlock(),
 			"nsxt_ip_block_subnet":                         resourceNsxtIPBlockSubnet(),
 			"nsxt_ip_pool":                                 resourceNsxtIPPool(),
 			"nsxt_ip_set":                                  resourceNsxtIPSet(),
+			"nsxt_ipfix_collector_profile":                 resourceNsxtIpfixCollectorProfile(),
+			"nsxt_ipfix_switch_config":                     resourceNsxtIpfixSwitchConfig(),
+			"nsxt_mac_set":                                 resourceNsxtMacSet(),
 			"nsxt_static_route":                            resourceNsxtStaticRoute(),
 			"nsxt_vm_tags":                                 resourceNsxtVMTags(),
 			"nsxt_lb_icmp_monitor":                         resourceNsxtLbIcmpMonitor(),
@@ -137,6 +176,7 @@ func Provider() terraform.ResourceProvider {
 			"nsxt_lb_https_monitor":                        resourceNsxtLbHTTPSMonitor(),
 			"nsxt_lb_passive_monitor":                      resourceNsxtLbPassiveMonitor(),
 			"nsxt_lb_pool":                                 resourceNsxtLbPool(),
+			"nsxt_lb_pool_member":                          resourceNsxtLbPoolMember(),
 			"nsxt_lb_tcp_virtual_server":                   resourceNsxtLbTCPVirtualServer(),
 			"nsxt_lb_udp_virtual_server":                   resourceNsxtLbUDPVirtualServer(),
 			"nsxt_lb_http_virtual_server":                  resourceNsxtLbHTTPVirtualServer(),
@@ -0,0 +1,176 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/appdiscovery"
+	"log"
+	"net/http"
+	"time"
+)
+
+func resourceNsxtAppDiscoverySession() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtAppDiscoverySessionCreate,
+		Read:   resourceNsxtAppDiscoverySessionRead,
+		Delete: resourceNsxtAppDiscoverySessionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ns_group_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "IDs of the NSGroups whose virtual machines are scanned in this session",
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"app_profile_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "IDs of the app profiles used to classify the discovered apps",
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status":          getAppDiscoverySessionStatusSchema(),
+			"start_timestamp": getAppDiscoverySessionStartTimestampSchema(),
+			"end_timestamp":   getAppDiscoverySessionEndTimestampSchema(),
+		},
+	}
+}
+
+func getAppDiscoverySessionStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The status of the session",
+		Computed:    true,
+	}
+}
+
+func getAppDiscoverySessionStartTimestampSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Time when the session was started, in epoch milliseconds",
+		Computed:    true,
+	}
+}
+
+func getAppDiscoverySessionEndTimestampSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Time when the session finished, in epoch milliseconds",
+		Computed:    true,
+	}
+}
+
+func resourceNsxtAppDiscoverySessionCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	parameters := appdiscovery.StartAppDiscoverySessionParameters{
+		NsGroupIds:    getStringListFromSchemaSet(d, "ns_group_ids"),
+		AppProfileIds: getStringListFromSchemaSet(d, "app_profile_ids"),
+	}
+
+	session, resp, err := nsxClient.AppDiscoveryApi.StartAppDiscoverySession(nsxClient.Context, parameters)
+
+	if err != nil {
+		return fmt.Errorf("Error during AppDiscoverySession start: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during AppDiscoverySession start: %v", resp.StatusCode)
+	}
+	d.SetId(session.Id)
+
+	// Wait for the session to complete, so its results are available to dependent resources
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"RUNNING"},
+		Target:  []string{"FINISHED"},
+		Refresh: func() (interface{}, string, error) {
+			session, resp, err := nsxClient.AppDiscoveryApi.GetAppDiscoverySession(nsxClient.Context, session.Id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying session status: %v", err)
+			}
+
+			if resp.StatusCode != http.StatusOK {
+				return nil, "", fmt.Errorf("Unexpected return status %d", resp.StatusCode)
+			}
+
+			if session.Status == "FAILED" {
+				return nil, "", fmt.Errorf("App discovery session %s failed", session.Id)
+			}
+
+			log.Printf("[DEBUG] App discovery session status: %s", session.Status)
+			return session, session.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtAppDiscoverySessionRead(d, m)
+}
+
+func resourceNsxtAppDiscoverySessionRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	summary, resp, err := nsxClient.AppDiscoveryApi.GetAppDiscoverySessionSummary(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] AppDiscoverySession %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during AppDiscoverySession read: %v", err)
+	}
+
+	var nsGroupIDs []string
+	for _, nsGroup := range summary.NsGroups {
+		nsGroupIDs = append(nsGroupIDs, nsGroup.NsGroupId)
+	}
+	var appProfileIDs []string
+	for _, appProfile := range summary.AppProfiles {
+		appProfileIDs = append(appProfileIDs, appProfile.AppProfileId)
+	}
+
+	d.Set("ns_group_ids", nsGroupIDs)
+	d.Set("app_profile_ids", appProfileIDs)
+	d.Set("status", summary.Status)
+	d.Set("start_timestamp", summary.StartTimestamp)
+	d.Set("end_timestamp", summary.EndTimestamp)
+
+	return nil
+}
+
+func resourceNsxtAppDiscoverySessionDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.AppDiscoveryApi.DeleteAppDiscoverySession(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during AppDiscoverySession delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] AppDiscoverySession %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
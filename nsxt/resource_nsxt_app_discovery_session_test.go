@@ -0,0 +1,127 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+func TestAccResourceNsxtAppDiscoverySession_basic(t *testing.T) {
+	testResourceName := "nsxt_app_discovery_session.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXAppDiscoverySessionCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXAppDiscoverySessionCreateTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXAppDiscoverySessionExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "ns_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "app_profile_ids.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "status", "FINISHED"),
+					resource.TestCheckResourceAttrSet(testResourceName, "start_timestamp"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtAppDiscoverySession_importBasic(t *testing.T) {
+	testResourceName := "nsxt_app_discovery_session.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXAppDiscoverySessionCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXAppDiscoverySessionCreateTemplate(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXAppDiscoverySessionExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("App discovery session resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("App discovery session resource ID not set in resources ")
+		}
+
+		_, responseCode, err := nsxClient.AppDiscoveryApi.GetAppDiscoverySession(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving app discovery session ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if app discovery session %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+func testAccNSXAppDiscoverySessionCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_app_discovery_session" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		_, responseCode, err := nsxClient.AppDiscoveryApi.GetAppDiscoverySession(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving app discovery session ID %s. Error: %v", resourceID, err)
+		}
+
+		return fmt.Errorf("App discovery session %s still exists", resourceID)
+	}
+	return nil
+}
+
+func testAccNSXAppDiscoverySessionCreateTemplate() string {
+	return fmt.Sprintf(`
+resource "nsxt_ns_group" "test" {
+  display_name = "app-discovery-test-group"
+}
+
+resource "nsxt_app_profile" "test" {
+  display_name         = "app-discovery-test-profile"
+  app_profile_category = "DATABASE_SERVERS"
+  app_profile_criteria = ["MySQL*"]
+}
+
+resource "nsxt_app_discovery_session" "test" {
+  ns_group_ids    = ["${nsxt_ns_group.test.id}"]
+  app_profile_ids = ["${nsxt_app_profile.test.id}"]
+}`)
+}
@@ -0,0 +1,147 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/appdiscovery"
+	"log"
+	"net/http"
+)
+
+func resourceNsxtAppProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtAppProfileCreate,
+		Read:   resourceNsxtAppProfileRead,
+		Update: resourceNsxtAppProfileUpdate,
+		Delete: resourceNsxtAppProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"app_profile_category": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Category of the app profile",
+				Required:    true,
+			},
+			"app_profile_criteria": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "Criteria (application names or patterns) used to match the installed apps",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func getAppProfileFromSchema(d *schema.ResourceData) appdiscovery.AppProfile {
+	return appdiscovery.AppProfile{
+		Description:        d.Get("description").(string),
+		DisplayName:        d.Get("display_name").(string),
+		Tags:               getTagsFromSchema(d),
+		AppProfileCategory: d.Get("app_profile_category").(string),
+		AppProfileCriteria: getStringListFromSchemaSet(d, "app_profile_criteria"),
+	}
+}
+
+func resourceNsxtAppProfileCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	appProfile := getAppProfileFromSchema(d)
+
+	appProfile, resp, err := nsxClient.AppDiscoveryApi.AddAppProfile(nsxClient.Context, appProfile)
+
+	if err != nil {
+		return fmt.Errorf("Error during AppProfile create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during AppProfile create: %v", resp.StatusCode)
+	}
+	d.SetId(appProfile.Id)
+
+	return resourceNsxtAppProfileRead(d, m)
+}
+
+func resourceNsxtAppProfileRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	appProfile, resp, err := nsxClient.AppDiscoveryApi.GetAppProfileDetails(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] AppProfile %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during AppProfile read: %v", err)
+	}
+
+	d.Set("revision", appProfile.Revision)
+	d.Set("description", appProfile.Description)
+	d.Set("display_name", appProfile.DisplayName)
+	setTagsInSchema(d, appProfile.Tags)
+	d.Set("app_profile_category", appProfile.AppProfileCategory)
+	d.Set("app_profile_criteria", appProfile.AppProfileCriteria)
+
+	return nil
+}
+
+func resourceNsxtAppProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	appProfile := getAppProfileFromSchema(d)
+	appProfile.Revision = int64(d.Get("revision").(int))
+
+	_, resp, err := nsxClient.AppDiscoveryApi.UpdateAppProfile(nsxClient.Context, id, appProfile)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during AppProfile update: %v", err)
+	}
+
+	return resourceNsxtAppProfileRead(d, m)
+}
+
+func resourceNsxtAppProfileDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	localVarOptionals["force"] = true
+	resp, err := nsxClient.AppDiscoveryApi.DeleteAppProfile(nsxClient.Context, id, localVarOptionals)
+	if err != nil {
+		return fmt.Errorf("Error during AppProfile delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] AppProfile %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
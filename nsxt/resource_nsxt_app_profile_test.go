@@ -0,0 +1,164 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+func TestAccResourceNsxtAppProfile_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-app-profile")
+	updateName := fmt.Sprintf("%s-update", name)
+	testResourceName := "nsxt_app_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXAppProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXAppProfileCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXAppProfileExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "app_profile_category", "DATABASE_SERVERS"),
+					resource.TestCheckResourceAttr(testResourceName, "app_profile_criteria.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXAppProfileUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXAppProfileExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "app_profile_category", "DATABASE_SERVERS"),
+					resource.TestCheckResourceAttr(testResourceName, "app_profile_criteria.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtAppProfile_importBasic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-app-profile")
+	testResourceName := "nsxt_app_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXAppProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXAppProfileCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXAppProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("App profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("App profile resource ID not set in resources ")
+		}
+
+		appProfile, responseCode, err := nsxClient.AppDiscoveryApi.GetAppProfileDetails(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving app profile ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if app profile %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == appProfile.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("App profile %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXAppProfileCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_app_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		appProfile, responseCode, err := nsxClient.AppDiscoveryApi.GetAppProfileDetails(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving app profile ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == appProfile.DisplayName {
+			return fmt.Errorf("App profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXAppProfileCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_app_profile" "test" {
+  display_name         = "%s"
+  description          = "Acceptance Test"
+  app_profile_category = "DATABASE_SERVERS"
+  app_profile_criteria = ["MySQL*"]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXAppProfileUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_app_profile" "test" {
+  display_name         = "%s"
+  description          = "Acceptance Test Update"
+  app_profile_category = "DATABASE_SERVERS"
+  app_profile_criteria = ["MySQL*", "PostgreSQL*"]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName)
+}
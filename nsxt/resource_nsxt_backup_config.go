@@ -0,0 +1,189 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/administration"
+	"log"
+)
+
+const backupConfigID = "backup"
+
+// The client only models the schedule type and not its interval, so the
+// backups always run at the default interval of the interval schedule
+const backupScheduleType = "IntervalBackupSchedule"
+
+func resourceNsxtBackupConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtBackupConfigCreate,
+		Read:   resourceNsxtBackupConfigRead,
+		Update: resourceNsxtBackupConfigUpdate,
+		Delete: resourceNsxtBackupConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the automated backups are enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"inventory_summary_interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Minimum number of seconds between each upload of the inventory summary to the backup server",
+				Optional:     true,
+				Default:      240,
+				ValidateFunc: validation.IntBetween(30, 86400),
+			},
+			"passphrase": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Passphrase used to encrypt the backup files",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"server": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Hostname or IP address of the SFTP server to which the backups are sent",
+				Required:    true,
+			},
+			"port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Port of the SFTP server",
+				Optional:     true,
+				Default:      22,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"directory_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Directory of the SFTP server in which the backups are stored",
+				Required:    true,
+			},
+			"ssh_fingerprint": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Expected SHA256 ECDSA SSH fingerprint of the SFTP server",
+				Required:    true,
+			},
+			"username": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "User name to authenticate with the SFTP server",
+				Required:    true,
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Password to authenticate with the SFTP server",
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func getBackupConfigFromSchema(d *schema.ResourceData) administration.BackupConfiguration {
+	return administration.BackupConfiguration{
+		BackupEnabled: d.Get("backup_enabled").(bool),
+		BackupSchedule: &administration.BackupSchedule{
+			ResourceType: backupScheduleType,
+		},
+		InventorySummaryInterval: int64(d.Get("inventory_summary_interval").(int)),
+		Passphrase:               d.Get("passphrase").(string),
+		RemoteFileServer: &administration.RemoteFileServer{
+			Server:        d.Get("server").(string),
+			Port:          int64(d.Get("port").(int)),
+			DirectoryPath: d.Get("directory_path").(string),
+			Protocol: &administration.FileTransferProtocol{
+				ProtocolName:   "sftp",
+				SshFingerprint: d.Get("ssh_fingerprint").(string),
+				AuthenticationScheme: &administration.FileTransferAuthenticationScheme{
+					SchemeName: "PASSWORD",
+					Username:   d.Get("username").(string),
+					Password:   d.Get("password").(string),
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtBackupConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	config := getBackupConfigFromSchema(d)
+
+	_, _, err := nsxClient.NsxComponentAdministrationApi.ConfigureBackupConfig(nsxClient.Context, config)
+	if err != nil {
+		return fmt.Errorf("Error during BackupConfiguration create: %v", err)
+	}
+
+	d.SetId(backupConfigID)
+
+	return resourceNsxtBackupConfigRead(d, m)
+}
+
+func resourceNsxtBackupConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	config, _, err := nsxClient.NsxComponentAdministrationApi.GetBackupConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during BackupConfiguration read: %v", err)
+	}
+
+	// The passphrase and the password are not returned by NSX
+	d.Set("backup_enabled", config.BackupEnabled)
+	d.Set("inventory_summary_interval", config.InventorySummaryInterval)
+	if config.RemoteFileServer != nil {
+		d.Set("server", config.RemoteFileServer.Server)
+		d.Set("port", config.RemoteFileServer.Port)
+		d.Set("directory_path", config.RemoteFileServer.DirectoryPath)
+		if config.RemoteFileServer.Protocol != nil {
+			d.Set("ssh_fingerprint", config.RemoteFileServer.Protocol.SshFingerprint)
+			if config.RemoteFileServer.Protocol.AuthenticationScheme != nil {
+				d.Set("username", config.RemoteFileServer.Protocol.AuthenticationScheme.Username)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtBackupConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	config := getBackupConfigFromSchema(d)
+	_, _, err := nsxClient.NsxComponentAdministrationApi.ConfigureBackupConfig(nsxClient.Context, config)
+	if err != nil {
+		return fmt.Errorf("Error during BackupConfiguration update: %v", err)
+	}
+
+	return resourceNsxtBackupConfigRead(d, m)
+}
+
+func resourceNsxtBackupConfigDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	config := getBackupConfigFromSchema(d)
+	config.BackupEnabled = false
+	_, _, err := nsxClient.NsxComponentAdministrationApi.ConfigureBackupConfig(nsxClient.Context, config)
+	if err != nil {
+		return fmt.Errorf("Error during BackupConfiguration delete: %v", err)
+	}
+
+	log.Printf("[DEBUG] BackupConfiguration %s disabled", id)
+	return nil
+}
@@ -0,0 +1,105 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"os"
+	"testing"
+)
+
+func testAccNSXBackupConfigPreCheck(t *testing.T) {
+	testAccEnvDefined(t, "NSXT_TEST_BACKUP_SERVER")
+	testAccEnvDefined(t, "NSXT_TEST_BACKUP_SSH_FINGERPRINT")
+	testAccEnvDefined(t, "NSXT_TEST_BACKUP_USERNAME")
+	testAccEnvDefined(t, "NSXT_TEST_BACKUP_PASSWORD")
+}
+
+func TestAccResourceNsxtBackupConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_backup_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccNSXBackupConfigPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXBackupConfigCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXBackupConfigTemplate("/tmp/nsx-backup", 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "id", "backup"),
+					resource.TestCheckResourceAttr(testResourceName, "backup_enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "directory_path", "/tmp/nsx-backup"),
+					resource.TestCheckResourceAttr(testResourceName, "inventory_summary_interval", "300"),
+				),
+			},
+			{
+				Config: testAccNSXBackupConfigTemplate("/tmp/nsx-backup-update", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "directory_path", "/tmp/nsx-backup-update"),
+					resource.TestCheckResourceAttr(testResourceName, "inventory_summary_interval", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtBackupConfig_importBasic(t *testing.T) {
+	testResourceName := "nsxt_backup_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccNSXBackupConfigPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXBackupConfigCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXBackupConfigTemplate("/tmp/nsx-backup", 300),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "passphrase"},
+			},
+		},
+	})
+}
+
+func testAccNSXBackupConfigCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	config, _, err := nsxClient.NsxComponentAdministrationApi.GetBackupConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while retrieving backup configuration. Error: %v", err)
+	}
+
+	if config.BackupEnabled {
+		return fmt.Errorf("Backup configuration was not disabled")
+	}
+	return nil
+}
+
+func testAccNSXBackupConfigTemplate(directoryPath string, inventoryInterval int) string {
+	return fmt.Sprintf(`
+resource "nsxt_backup_config" "test" {
+  server                     = "%s"
+  ssh_fingerprint            = "%s"
+  username                   = "%s"
+  password                   = "%s"
+  directory_path             = "%s"
+  passphrase                 = "Acceptance-Test-Passphrase1"
+  inventory_summary_interval = %d
+}`, os.Getenv("NSXT_TEST_BACKUP_SERVER"), os.Getenv("NSXT_TEST_BACKUP_SSH_FINGERPRINT"), os.Getenv("NSXT_TEST_BACKUP_USERNAME"), os.Getenv("NSXT_TEST_BACKUP_PASSWORD"), directoryPath, inventoryInterval)
+}
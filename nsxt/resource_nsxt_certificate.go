@@ -0,0 +1,206 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/trust"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Default number of days before expiry from which a warning is shown during plan
+const certificateExpiryWarningDays = 30
+
+// The expiry warning is given during validation, before the provider is
+// configured, so its threshold is taken from the environment
+func getCertificateExpiryWarningDays() int {
+	value := os.Getenv("NSXT_CERTIFICATE_EXPIRY_WARNING_DAYS")
+	if value == "" {
+		return certificateExpiryWarningDays
+	}
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 {
+		log.Printf("[WARN] Invalid NSXT_CERTIFICATE_EXPIRY_WARNING_DAYS value %s, using %d days", value, certificateExpiryWarningDays)
+		return certificateExpiryWarningDays
+	}
+	return days
+}
+
+// Certificates cannot be modified in NSX, so all arguments force a new resource
+func resourceNsxtCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtCertificateCreate,
+		Read:   resourceNsxtCertificateRead,
+		Delete: resourceNsxtCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"tag": getTagsSchemaForceNew(),
+			"pem_encoded": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "PEM encoded certificate or certificate chain",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCertificatePem(getCertificateExpiryWarningDays()),
+			},
+			"private_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "PEM encoded private key of the certificate",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"passphrase": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Passphrase of the private key",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"key_algo": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Key algorithm contained in this certificate",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"expiry": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Expiry time of the certificate, in RFC 3339 format",
+				Computed:    true,
+			},
+			"subject_cn": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Common name of the certificate subject",
+				Computed:    true,
+			},
+			"issuer_cn": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Common name of the certificate issuer",
+				Computed:    true,
+			},
+			"serial_number": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Serial number of the certificate",
+				Computed:    true,
+			},
+			"is_ca": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether this is a certificate authority certificate",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	trustObject := trust.TrustObjectData{
+		Description: d.Get("description").(string),
+		DisplayName: d.Get("display_name").(string),
+		Tags:        getTagsFromSchema(d),
+		PemEncoded:  d.Get("pem_encoded").(string),
+		PrivateKey:  d.Get("private_key").(string),
+		Passphrase:  d.Get("passphrase").(string),
+		KeyAlgo:     d.Get("key_algo").(string),
+	}
+
+	certList, resp, err := nsxClient.NsxComponentAdministrationApi.AddCertificateImport(nsxClient.Context, trustObject)
+
+	if err != nil {
+		return fmt.Errorf("Error during Certificate import: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during Certificate import: %v", resp.StatusCode)
+	}
+	if len(certList.Results) == 0 {
+		return fmt.Errorf("No certificate was returned by the Certificate import")
+	}
+	d.SetId(certList.Results[0].Id)
+
+	return resourceNsxtCertificateRead(d, m)
+}
+
+func resourceNsxtCertificateRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	localVarOptionals["details"] = true
+	cert, resp, err := nsxClient.NsxComponentAdministrationApi.GetCertificate(nsxClient.Context, id, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Certificate %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Certificate read: %v", err)
+	}
+
+	d.Set("revision", cert.Revision)
+	d.Set("description", cert.Description)
+	d.Set("display_name", cert.DisplayName)
+	setTagsInSchema(d, cert.Tags)
+	// NSX may reformat the PEM, so it is only set when missing (on import)
+	if d.Get("pem_encoded").(string) == "" {
+		d.Set("pem_encoded", cert.PemEncoded)
+	}
+	if len(cert.Details) > 0 {
+		// The first entry is the leaf certificate of the chain
+		details := cert.Details[0]
+		d.Set("key_algo", details.PublicKeyAlgo)
+		d.Set("expiry", time.Unix(0, details.NotAfter*int64(time.Millisecond)).UTC().Format(time.RFC3339))
+		d.Set("subject_cn", details.SubjectCn)
+		d.Set("issuer_cn", details.IssuerCn)
+		d.Set("serial_number", details.SerialNumber)
+		d.Set("is_ca", details.IsCa)
+	}
+
+	return nil
+}
+
+func resourceNsxtCertificateDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.NsxComponentAdministrationApi.DeleteCertificate(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during Certificate delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Certificate %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
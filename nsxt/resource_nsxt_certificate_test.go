@@ -0,0 +1,196 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Generate a self signed CA certificate, returning the PEM encoded certificate
+// and private key along with the parsed objects for signing further artifacts
+func testAccNSXGenerateCertificate(t *testing.T, commonName string) (string, string, *x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(now.UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal private key: %v", err)
+	}
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPem), string(keyPem), cert, key
+}
+
+func TestAccResourceNsxtCertificate_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-certificate")
+	updateName := fmt.Sprintf("%s-update", name)
+	testResourceName := "nsxt_certificate.test"
+	certPem, keyPem, _, _ := testAccNSXGenerateCertificate(t, "test.nsx.local")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXCertificateTemplate(name, certPem, keyPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXCertificateExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "subject_cn", "test.nsx.local"),
+					resource.TestCheckResourceAttr(testResourceName, "is_ca", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "expiry"),
+					resource.TestCheckResourceAttrSet(testResourceName, "serial_number"),
+				),
+			},
+			{
+				Config: testAccNSXCertificateTemplate(updateName, certPem, keyPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXCertificateExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "subject_cn", "test.nsx.local"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtCertificate_importBasic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-certificate")
+	testResourceName := "nsxt_certificate.test"
+	certPem, keyPem, _, _ := testAccNSXGenerateCertificate(t, "test.nsx.local")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXCertificateTemplate(name, certPem, keyPem),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_encoded", "private_key", "passphrase"},
+			},
+		},
+	})
+}
+
+func testAccNSXCertificateExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Certificate resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Certificate resource ID not set in resources ")
+		}
+
+		localVarOptionals := make(map[string]interface{})
+		cert, responseCode, err := nsxClient.NsxComponentAdministrationApi.GetCertificate(nsxClient.Context, resourceID, localVarOptionals)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving certificate ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if certificate %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == cert.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Certificate %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXCertificateCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_certificate" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		localVarOptionals := make(map[string]interface{})
+		cert, responseCode, err := nsxClient.NsxComponentAdministrationApi.GetCertificate(nsxClient.Context, resourceID, localVarOptionals)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving certificate ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == cert.DisplayName {
+			return fmt.Errorf("Certificate %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXCertificateTemplate(name string, certPem string, keyPem string) string {
+	return fmt.Sprintf(`
+resource "nsxt_certificate" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  pem_encoded = <<EOT
+%sEOT
+
+  private_key = <<EOT
+%sEOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, certPem, keyPem)
+}
@@ -0,0 +1,171 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/trust"
+	"log"
+	"net/http"
+)
+
+func resourceNsxtCrl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtCrlCreate,
+		Read:   resourceNsxtCrlRead,
+		Update: resourceNsxtCrlUpdate,
+		Delete: resourceNsxtCrlDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"pem_encoded": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "PEM encoded certificate revocation list",
+				Required:    true,
+			},
+			"issuer": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Issuer of the certificate revocation list",
+				Computed:    true,
+			},
+			"next_update": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Next update time of the certificate revocation list",
+				Computed:    true,
+			},
+			"revoked_serial_numbers": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Serial numbers of the revoked certificates",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNsxtCrlCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	crlObject := trust.CrlObjectData{
+		Description: d.Get("description").(string),
+		DisplayName: d.Get("display_name").(string),
+		Tags:        getTagsFromSchema(d),
+		PemEncoded:  d.Get("pem_encoded").(string),
+	}
+
+	crlList, resp, err := nsxClient.NsxComponentAdministrationApi.AddCrlImport(nsxClient.Context, crlObject)
+
+	if err != nil {
+		return fmt.Errorf("Error during Crl import: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during Crl import: %v", resp.StatusCode)
+	}
+	if len(crlList.Results) == 0 {
+		return fmt.Errorf("No CRL was returned by the Crl import")
+	}
+	d.SetId(crlList.Results[0].Id)
+
+	return resourceNsxtCrlRead(d, m)
+}
+
+func resourceNsxtCrlRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	localVarOptionals["details"] = true
+	crl, resp, err := nsxClient.NsxComponentAdministrationApi.GetCrl(nsxClient.Context, id, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Crl %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Crl read: %v", err)
+	}
+
+	d.Set("revision", crl.Revision)
+	d.Set("description", crl.Description)
+	d.Set("display_name", crl.DisplayName)
+	setTagsInSchema(d, crl.Tags)
+	// NSX may reformat the PEM, so it is only set when missing (on import)
+	if d.Get("pem_encoded").(string) == "" {
+		d.Set("pem_encoded", crl.PemEncoded)
+	}
+	if crl.Details != nil {
+		d.Set("issuer", crl.Details.Issuer)
+		d.Set("next_update", crl.Details.NextUpdate)
+		var serialNumbers []string
+		for _, entry := range crl.Details.CrlEntries {
+			serialNumbers = append(serialNumbers, entry.SerialNumber)
+		}
+		d.Set("revoked_serial_numbers", serialNumbers)
+	}
+
+	return nil
+}
+
+func resourceNsxtCrlUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	crl := trust.Crl{
+		Revision:    int64(d.Get("revision").(int)),
+		Description: d.Get("description").(string),
+		DisplayName: d.Get("display_name").(string),
+		Tags:        getTagsFromSchema(d),
+		PemEncoded:  d.Get("pem_encoded").(string),
+	}
+
+	_, resp, err := nsxClient.NsxComponentAdministrationApi.UpdateCrl(nsxClient.Context, id, crl)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during Crl update: %v", err)
+	}
+
+	return resourceNsxtCrlRead(d, m)
+}
+
+func resourceNsxtCrlDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.NsxComponentAdministrationApi.DeleteCrl(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during Crl delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Crl %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
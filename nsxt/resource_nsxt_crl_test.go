@@ -0,0 +1,193 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Generate a PEM encoded CRL revoking the given serial number, signed by a new CA
+func testAccNSXGenerateCrl(t *testing.T, serialNumber int64) string {
+	_, _, caCert, caKey := testAccNSXGenerateCertificate(t, "ca.nsx.local")
+	now := time.Now()
+	revoked := []pkix.RevokedCertificate{
+		{
+			SerialNumber:   big.NewInt(serialNumber),
+			RevocationTime: now.Add(-time.Hour),
+		},
+	}
+	der, err := caCert.CreateCRL(rand.Reader, caKey, revoked, now, now.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("Failed to create CRL: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}))
+}
+
+func TestAccResourceNsxtCrl_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-crl")
+	updateName := fmt.Sprintf("%s-update", name)
+	testResourceName := "nsxt_crl.test"
+	crlPem := testAccNSXGenerateCrl(t, 1001)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXCrlCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXCrlCreateTemplate(name, crlPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXCrlExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "revoked_serial_numbers.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "issuer"),
+					resource.TestCheckResourceAttrSet(testResourceName, "next_update"),
+				),
+			},
+			{
+				Config: testAccNSXCrlUpdateTemplate(updateName, crlPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXCrlExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "revoked_serial_numbers.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtCrl_importBasic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-crl")
+	testResourceName := "nsxt_crl.test"
+	crlPem := testAccNSXGenerateCrl(t, 1001)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXCrlCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXCrlCreateTemplate(name, crlPem),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_encoded"},
+			},
+		},
+	})
+}
+
+func testAccNSXCrlExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("CRL resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("CRL resource ID not set in resources ")
+		}
+
+		localVarOptionals := make(map[string]interface{})
+		crl, responseCode, err := nsxClient.NsxComponentAdministrationApi.GetCrl(nsxClient.Context, resourceID, localVarOptionals)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving CRL ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if CRL %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == crl.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("CRL %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXCrlCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_crl" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		localVarOptionals := make(map[string]interface{})
+		crl, responseCode, err := nsxClient.NsxComponentAdministrationApi.GetCrl(nsxClient.Context, resourceID, localVarOptionals)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving CRL ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == crl.DisplayName {
+			return fmt.Errorf("CRL %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXCrlCreateTemplate(name string, crlPem string) string {
+	return fmt.Sprintf(`
+resource "nsxt_crl" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  pem_encoded = <<EOT
+%sEOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, crlPem)
+}
+
+func testAccNSXCrlUpdateTemplate(updatedName string, crlPem string) string {
+	return fmt.Sprintf(`
+resource "nsxt_crl" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test Update"
+
+  pem_encoded = <<EOT
+%sEOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName, crlPem)
+}
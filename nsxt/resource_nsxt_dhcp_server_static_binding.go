@@ -0,0 +1,296 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+)
+
+func resourceNsxtDhcpServerStaticBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtDhcpServerStaticBindingCreate,
+		Read:   resourceNsxtDhcpServerStaticBindingRead,
+		Update: resourceNsxtDhcpServerStaticBindingUpdate,
+		Delete: resourceNsxtDhcpServerStaticBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtDhcpServerStaticBindingImport,
+		},
+		CustomizeDiff: resourceNsxtDhcpServerStaticBindingCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"logical_dhcp_server_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Id of dhcp server this static binding belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"mac_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "MAC address of the host",
+				Required:     true,
+				ValidateFunc: validateMacAddress(),
+			},
+			"ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "IP address assigned to the host",
+				Required:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"hostname": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Host name of the host",
+				Optional:    true,
+			},
+			"dhcp_option_121":     getDhcpOptions121Schema(),
+			"dhcp_generic_option": getDhcpGenericOptionsSchema(),
+			"gateway_ip": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Gateway ip",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"lease_time": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Lease time, in seconds",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(60, 4294967295),
+				Default:      86400,
+			},
+
+			"tag":      getTagsSchema(),
+			"revision": getRevisionSchema(),
+		},
+	}
+}
+
+func isIPInPoolRange(ip net.IP, ipRange manager.IpPoolRange) bool {
+	start := net.ParseIP(ipRange.Start)
+	end := net.ParseIP(ipRange.End)
+	if start == nil || end == nil {
+		return false
+	}
+	return bytes.Compare(ip.To16(), start.To16()) >= 0 && bytes.Compare(ip.To16(), end.To16()) <= 0
+}
+
+// Static binding addresses must not be in the allocation ranges of the DHCP server IP pools
+func checkDhcpStaticBindingConflicts(nsxClient *api.APIClient, serverID string, ipAddress string) error {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return nil
+	}
+
+	var pools []manager.DhcpIpPool
+	_, err := readAllPages(make(map[string]interface{}), func(localVarOptionals map[string]interface{}) (string, *http.Response, error) {
+		result, resp, err := nsxClient.ServicesApi.ListDhcpIpPools(nsxClient.Context, serverID, localVarOptionals)
+		pools = append(pools, result.Results...)
+		return result.Cursor, resp, err
+	})
+	if err != nil {
+		return fmt.Errorf("Error while reading IP pools of DHCP server %s: %v", serverID, err)
+	}
+
+	for _, pool := range pools {
+		for _, ipRange := range pool.AllocationRanges {
+			if isIPInPoolRange(ip, ipRange) {
+				return fmt.Errorf("IP address %s conflicts with range %s-%s of DHCP server IP pool %s", ipAddress, ipRange.Start, ipRange.End, pool.Id)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceNsxtDhcpServerStaticBindingCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("ip_address") && !d.HasChange("logical_dhcp_server_id") {
+		return nil
+	}
+
+	// Values which are not known yet at plan time are empty, and cannot be checked
+	serverID := d.Get("logical_dhcp_server_id").(string)
+	ipAddress := d.Get("ip_address").(string)
+	if serverID == "" || ipAddress == "" {
+		return nil
+	}
+
+	nsxClient := m.(*api.APIClient)
+	return checkDhcpStaticBindingConflicts(nsxClient, serverID, ipAddress)
+}
+
+func getDhcpStaticBindingFromSchema(d *schema.ResourceData) manager.DhcpStaticBinding {
+	opt121Routes := getDhcpOptions121(d)
+	var opt121 *manager.DhcpOption121
+	if opt121Routes != nil {
+		opt121 = &manager.DhcpOption121{
+			StaticRoutes: opt121Routes,
+		}
+	}
+	return manager.DhcpStaticBinding{
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Tags:        getTagsFromSchema(d),
+		MacAddress:  d.Get("mac_address").(string),
+		IpAddress:   d.Get("ip_address").(string),
+		HostName:    d.Get("hostname").(string),
+		GatewayIp:   d.Get("gateway_ip").(string),
+		LeaseTime:   int64(d.Get("lease_time").(int)),
+		Options: &manager.DhcpOptions{
+			Option121: opt121,
+			Others:    getDhcpGenericOptions(d),
+		},
+	}
+}
+
+func resourceNsxtDhcpServerStaticBindingCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	serverID := d.Get("logical_dhcp_server_id").(string)
+	binding := getDhcpStaticBindingFromSchema(d)
+
+	// IP pools created in the same apply were not known at plan time
+	err := checkDhcpStaticBindingConflicts(nsxClient, serverID, binding.IpAddress)
+	if err != nil {
+		return err
+	}
+
+	createdBinding, resp, err := nsxClient.ServicesApi.CreateDhcpStaticBinding(nsxClient.Context, serverID, binding)
+
+	if err != nil {
+		return fmt.Errorf("Error during DhcpStaticBinding create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during DhcpStaticBinding create: %v", resp.StatusCode)
+	}
+
+	d.SetId(createdBinding.Id)
+
+	return resourceNsxtDhcpServerStaticBindingRead(d, m)
+}
+
+func resourceNsxtDhcpServerStaticBindingRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	serverID := d.Get("logical_dhcp_server_id").(string)
+	if id == "" || serverID == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	binding, resp, err := nsxClient.ServicesApi.ReadDhcpStaticBinding(nsxClient.Context, serverID, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DhcpStaticBinding %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during DhcpStaticBinding read: %v", err)
+	}
+
+	d.Set("revision", binding.Revision)
+	d.Set("display_name", binding.DisplayName)
+	d.Set("description", binding.Description)
+	setTagsInSchema(d, binding.Tags)
+	d.Set("logical_dhcp_server_id", serverID)
+	d.Set("mac_address", binding.MacAddress)
+	d.Set("ip_address", binding.IpAddress)
+	d.Set("hostname", binding.HostName)
+	d.Set("gateway_ip", binding.GatewayIp)
+	d.Set("lease_time", binding.LeaseTime)
+
+	var routes []manager.ClasslessStaticRoute
+	var options []manager.GenericDhcpOption
+	if binding.Options != nil {
+		if binding.Options.Option121 != nil {
+			routes = binding.Options.Option121.StaticRoutes
+		}
+		options = binding.Options.Others
+	}
+	err = setDhcpOptions121InSchema(d, routes)
+	if err != nil {
+		return fmt.Errorf("Error during DhcpStaticBinding read option 121: %v", err)
+	}
+	err = setDhcpGenericOptionsInSchema(d, options)
+	if err != nil {
+		return fmt.Errorf("Error during DhcpStaticBinding read generic options: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtDhcpServerStaticBindingUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	serverID := d.Get("logical_dhcp_server_id").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	binding := getDhcpStaticBindingFromSchema(d)
+	binding.Revision = int64(d.Get("revision").(int))
+
+	if d.HasChange("ip_address") {
+		err := checkDhcpStaticBindingConflicts(nsxClient, serverID, binding.IpAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, resp, err := nsxClient.ServicesApi.UpdateDhcpStaticBinding(nsxClient.Context, serverID, id, binding)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during DhcpStaticBinding update: %v", err)
+	}
+
+	return resourceNsxtDhcpServerStaticBindingRead(d, m)
+}
+
+func resourceNsxtDhcpServerStaticBindingDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	serverID := d.Get("logical_dhcp_server_id").(string)
+	if id == "" || serverID == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.ServicesApi.DeleteDhcpStaticBinding(nsxClient.Context, serverID, id)
+	if err != nil {
+		return fmt.Errorf("Error during DhcpStaticBinding delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DhcpStaticBinding %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceNsxtDhcpServerStaticBindingImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("Please provide <dhcp-server-id>/<static-binding-id> as an input")
+	}
+
+	d.SetId(s[1])
+	d.Set("logical_dhcp_server_id", s[0])
+
+	return []*schema.ResourceData{d}, nil
+}
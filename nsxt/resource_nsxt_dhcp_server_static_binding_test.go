@@ -0,0 +1,235 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+var testNsxtDhcpServerStaticBindingResourceName = "nsxt_dhcp_server_static_binding.test"
+
+func TestAccResourceNsxtDhcpServerStaticBinding_basic(t *testing.T) {
+	name := "test"
+	updatedName := "test-update"
+	testResourceName := testNsxtDhcpServerStaticBindingResourceName
+	edgeClusterName := getEdgeClusterName()
+	ip := "1.1.1.50"
+	updatedIP := "1.1.1.51"
+	leaseTime := "999999"
+	updatedLeaseTime := "1000000"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDhcpServerStaticBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDhcpServerStaticBindingTemplate(edgeClusterName, name, ip, leaseTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDhcpServerStaticBindingExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "test"),
+					resource.TestCheckResourceAttrSet(testResourceName, "logical_dhcp_server_id"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_address", "02:00:00:00:00:01"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", ip),
+					resource.TestCheckResourceAttr(testResourceName, "hostname", "host1"),
+					resource.TestCheckResourceAttr(testResourceName, "gateway_ip", "1.1.1.1"),
+					resource.TestCheckResourceAttr(testResourceName, "lease_time", leaseTime),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_option_121.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_option_121.0.next_hop", "5.2.2.1"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_generic_option.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_generic_option.0.code", "119"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXDhcpServerStaticBindingTemplate(edgeClusterName, updatedName, updatedIP, updatedLeaseTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDhcpServerStaticBindingExists(updatedName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", updatedIP),
+					resource.TestCheckResourceAttr(testResourceName, "lease_time", updatedLeaseTime),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_option_121.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_generic_option.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDhcpServerStaticBinding_poolConflict(t *testing.T) {
+	name := "test"
+	edgeClusterName := getEdgeClusterName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDhcpServerStaticBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNSXDhcpServerStaticBindingConflictTemplate(edgeClusterName, name),
+				ExpectError: regexp.MustCompile("conflicts with range"),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDhcpServerStaticBinding_importBasic(t *testing.T) {
+	name := "test"
+	testResourceName := testNsxtDhcpServerStaticBindingResourceName
+	edgeClusterName := getEdgeClusterName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDhcpServerStaticBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDhcpServerStaticBindingTemplate(edgeClusterName, name, "1.1.1.50", "86400"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXDhcpServerStaticBindingImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNSXDhcpServerStaticBindingImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[testNsxtDhcpServerStaticBindingResourceName]
+	if !ok {
+		return "", fmt.Errorf("DHCP static binding %s not found in resources", testNsxtDhcpServerStaticBindingResourceName)
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("DHCP static binding resource ID not set in resources")
+	}
+	serverID := rs.Primary.Attributes["logical_dhcp_server_id"]
+	if serverID == "" {
+		return "", fmt.Errorf("DHCP static binding logical_dhcp_server_id not set in resources")
+	}
+	return fmt.Sprintf("%s/%s", serverID, resourceID), nil
+}
+
+func testAccNSXDhcpServerStaticBindingExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("DHCP static binding resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("DHCP static binding resource ID not set in resources ")
+		}
+		serverID := rs.Primary.Attributes["logical_dhcp_server_id"]
+
+		binding, responseCode, err := nsxClient.ServicesApi.ReadDhcpStaticBinding(nsxClient.Context, serverID, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving DHCP static binding ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if DHCP static binding %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == binding.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("DHCP static binding %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXDhcpServerStaticBindingCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_dhcp_server_static_binding" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		serverID := rs.Primary.Attributes["logical_dhcp_server_id"]
+		binding, responseCode, err := nsxClient.ServicesApi.ReadDhcpStaticBinding(nsxClient.Context, serverID, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving DHCP static binding ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == binding.DisplayName {
+			return fmt.Errorf("DHCP static binding %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXDhcpServerStaticBindingTemplate(edgeClusterName string, name string, ip string, lease string) string {
+	return testAccNSXCreateDhcpIPPoolPrerequisites(edgeClusterName) + fmt.Sprintf(`
+resource "nsxt_dhcp_server_static_binding" "test" {
+  display_name           = "%s"
+  description            = "test"
+  logical_dhcp_server_id = "${nsxt_logical_dhcp_server.DS.id}"
+  mac_address            = "02:00:00:00:00:01"
+  ip_address             = "%s"
+  hostname               = "host1"
+  gateway_ip             = "1.1.1.1"
+  lease_time             = %s
+
+  dhcp_option_121 {
+    network  = "5.5.5.0/24"
+    next_hop = "5.2.2.1"
+  }
+
+  dhcp_generic_option {
+    code   = "119"
+    values = ["abc"]
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, ip, lease)
+}
+
+func testAccNSXDhcpServerStaticBindingConflictTemplate(edgeClusterName string, name string) string {
+	return testAccNSXCreateDhcpIPPoolPrerequisites(edgeClusterName) + fmt.Sprintf(`
+resource "nsxt_dhcp_server_ip_pool" "test" {
+  logical_dhcp_server_id = "${nsxt_logical_dhcp_server.DS.id}"
+
+  ip_range {
+    start = "1.1.1.20"
+    end   = "1.1.1.40"
+  }
+}
+
+resource "nsxt_dhcp_server_static_binding" "test" {
+  display_name           = "%s"
+  logical_dhcp_server_id = "${nsxt_logical_dhcp_server.DS.id}"
+  mac_address            = "02:00:00:00:00:01"
+  ip_address             = "1.1.1.30"
+
+  depends_on = ["nsxt_dhcp_server_ip_pool.test"]
+}`, name)
+}
@@ -0,0 +1,155 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"log"
+)
+
+const dneGlobalConfigDefaultRekeyMarginTime int64 = 1
+
+// The DNE global configuration is a singleton, so its ID is fixed
+const dneGlobalConfigID = "dne_global_config"
+
+func resourceNsxtDneGlobalConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtDneGlobalConfigCreate,
+		Read:   resourceNsxtDneGlobalConfigRead,
+		Update: resourceNsxtDneGlobalConfigUpdate,
+		Delete: resourceNsxtDneGlobalConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"allow_mirrored": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether DNE protected east-west traffic will be dropped at mirroring stage",
+				Optional:    true,
+				Default:     false,
+			},
+			"rekey_margin_time": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Time period during which both old and new keys are valid, to accommodate key distribution delay (in minutes)",
+				Optional:    true,
+				Default:     dneGlobalConfigDefaultRekeyMarginTime,
+			},
+		},
+	}
+}
+
+func resourceNsxtDneGlobalConfigApply(d *schema.ResourceData, nsxClient *api.APIClient, config manager.DneGlobalConfig) error {
+	config.Description = d.Get("description").(string)
+	config.DisplayName = d.Get("display_name").(string)
+	config.Tags = getTagsFromSchema(d)
+	config.AllowMirrored = d.Get("allow_mirrored").(bool)
+	config.RekeyMarginTime = int64(d.Get("rekey_margin_time").(int))
+
+	_, _, err := nsxClient.ServicesApi.UpdateDneGlobalConfig(nsxClient.Context, config)
+	return err
+}
+
+func resourceNsxtDneGlobalConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+
+	// Get the current configuration in order to use its revision
+	config, _, err := nsxClient.ServicesApi.GetDneGlobalConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig read: %v", err)
+	}
+
+	err = resourceNsxtDneGlobalConfigApply(d, nsxClient, config)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig create: %v", err)
+	}
+
+	d.SetId(dneGlobalConfigID)
+
+	return resourceNsxtDneGlobalConfigRead(d, m)
+}
+
+func resourceNsxtDneGlobalConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	config, _, err := nsxClient.ServicesApi.GetDneGlobalConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig read: %v", err)
+	}
+
+	d.Set("revision", config.Revision)
+	d.Set("description", config.Description)
+	d.Set("display_name", config.DisplayName)
+	setTagsInSchema(d, config.Tags)
+	d.Set("allow_mirrored", config.AllowMirrored)
+	d.Set("rekey_margin_time", config.RekeyMarginTime)
+
+	return nil
+}
+
+func resourceNsxtDneGlobalConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	config, _, err := nsxClient.ServicesApi.GetDneGlobalConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig read: %v", err)
+	}
+	config.Revision = int64(d.Get("revision").(int))
+	err = resourceNsxtDneGlobalConfigApply(d, nsxClient, config)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig update: %v", err)
+	}
+
+	return resourceNsxtDneGlobalConfigRead(d, m)
+}
+
+func resourceNsxtDneGlobalConfigDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	// Restore the default values
+	config, _, err := nsxClient.ServicesApi.GetDneGlobalConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig read: %v", err)
+	}
+	config.Description = ""
+	config.DisplayName = ""
+	config.Tags = nil
+	config.AllowMirrored = false
+	config.RekeyMarginTime = dneGlobalConfigDefaultRekeyMarginTime
+	_, _, err = nsxClient.ServicesApi.UpdateDneGlobalConfig(nsxClient.Context, config)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig delete: %v", err)
+	}
+
+	log.Printf("[DEBUG] DneGlobalConfig %s restored to defaults", id)
+	return nil
+}
@@ -0,0 +1,101 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"testing"
+)
+
+func TestAccResourceNsxtDneGlobalConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_dne_global_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneGlobalConfigCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneGlobalConfigTemplate("true", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneGlobalConfigCheck(true, 10),
+					resource.TestCheckResourceAttr(testResourceName, "id", "dne_global_config"),
+					resource.TestCheckResourceAttr(testResourceName, "allow_mirrored", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "rekey_margin_time", "10"),
+				),
+			},
+			{
+				Config: testAccNSXDneGlobalConfigTemplate("false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneGlobalConfigCheck(false, 20),
+					resource.TestCheckResourceAttr(testResourceName, "allow_mirrored", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "rekey_margin_time", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDneGlobalConfig_importBasic(t *testing.T) {
+	testResourceName := "nsxt_dne_global_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneGlobalConfigCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneGlobalConfigTemplate("true", 10),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXDneGlobalConfigCheck(allowMirrored bool, rekeyMarginTime int64) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+		config, _, err := nsxClient.ServicesApi.GetDneGlobalConfig(nsxClient.Context)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving DNE global configuration. Error: %v", err)
+		}
+
+		if config.AllowMirrored != allowMirrored || config.RekeyMarginTime != rekeyMarginTime {
+			return fmt.Errorf("DNE global configuration was not updated")
+		}
+		return nil
+	}
+}
+
+func testAccNSXDneGlobalConfigCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	config, _, err := nsxClient.ServicesApi.GetDneGlobalConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while retrieving DNE global configuration. Error: %v", err)
+	}
+
+	if config.AllowMirrored || config.RekeyMarginTime != dneGlobalConfigDefaultRekeyMarginTime {
+		return fmt.Errorf("DNE global configuration was not restored to defaults")
+	}
+	return nil
+}
+
+func testAccNSXDneGlobalConfigTemplate(allowMirrored string, rekeyMarginTime int) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_global_config" "test" {
+  allow_mirrored    = %s
+  rekey_margin_time = %d
+}`, allowMirrored, rekeyMarginTime)
+}
@@ -0,0 +1,189 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"log"
+	"net/http"
+)
+
+func resourceNsxtDneKeyPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtDneKeyPolicyCreate,
+		Read:   resourceNsxtDneKeyPolicyRead,
+		Update: resourceNsxtDneKeyPolicyUpdate,
+		Delete: resourceNsxtDneKeyPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"encrypt_algorithm": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "DNE key encryption algorithm",
+				Required:    true,
+			},
+			"encrypt_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Key policy encryption type",
+				Required:    true,
+			},
+			"mac_algorithm": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "MAC algorithm type",
+				Required:    true,
+			},
+			"is_default": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether this key policy is the default one",
+				Optional:    true,
+			},
+			"is_sys_default": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether this key policy is a system default one",
+				Computed:    true,
+			},
+			"notes": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "User notes specific to the key policy",
+				Optional:    true,
+			},
+			"rekey_frequency": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Frequency of key policy rekey (in seconds)",
+				Optional:     true,
+				Default:      2592000,
+				ValidateFunc: validation.IntBetween(86400, 864000000),
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Key policy type",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getDneKeyPolicyFromSchema(d *schema.ResourceData) manager.DneKeyPolicy {
+	return manager.DneKeyPolicy{
+		Description:      d.Get("description").(string),
+		DisplayName:      d.Get("display_name").(string),
+		Tags:             getTagsFromSchema(d),
+		EncryptAlgorithm: d.Get("encrypt_algorithm").(string),
+		EncryptType:      d.Get("encrypt_type").(string),
+		MacAlgorithm:     d.Get("mac_algorithm").(string),
+		IsDefault:        d.Get("is_default").(bool),
+		Notes:            d.Get("notes").(string),
+		RekeyFrequency:   int64(d.Get("rekey_frequency").(int)),
+		Type_:            d.Get("type").(string),
+	}
+}
+
+func resourceNsxtDneKeyPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	keyPolicy := getDneKeyPolicyFromSchema(d)
+
+	keyPolicy, resp, err := nsxClient.ServicesApi.AddDneKeyPolicy(nsxClient.Context, keyPolicy)
+
+	if err != nil {
+		return fmt.Errorf("Error during DneKeyPolicy create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during DneKeyPolicy create: %v", resp.StatusCode)
+	}
+	d.SetId(keyPolicy.Id)
+
+	return resourceNsxtDneKeyPolicyRead(d, m)
+}
+
+func resourceNsxtDneKeyPolicyRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	keyPolicy, resp, err := nsxClient.ServicesApi.GetDneKeyPolicy(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DneKeyPolicy %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during DneKeyPolicy read: %v", err)
+	}
+
+	d.Set("revision", keyPolicy.Revision)
+	d.Set("description", keyPolicy.Description)
+	d.Set("display_name", keyPolicy.DisplayName)
+	setTagsInSchema(d, keyPolicy.Tags)
+	d.Set("encrypt_algorithm", keyPolicy.EncryptAlgorithm)
+	d.Set("encrypt_type", keyPolicy.EncryptType)
+	d.Set("mac_algorithm", keyPolicy.MacAlgorithm)
+	d.Set("is_default", keyPolicy.IsDefault)
+	d.Set("is_sys_default", keyPolicy.IsSysDefault)
+	d.Set("notes", keyPolicy.Notes)
+	d.Set("rekey_frequency", keyPolicy.RekeyFrequency)
+	d.Set("type", keyPolicy.Type_)
+
+	return nil
+}
+
+func resourceNsxtDneKeyPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	keyPolicy := getDneKeyPolicyFromSchema(d)
+	keyPolicy.Revision = int64(d.Get("revision").(int))
+
+	_, resp, err := nsxClient.ServicesApi.UpdateDneKeyPolicy(nsxClient.Context, id, keyPolicy)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during DneKeyPolicy update: %v", err)
+	}
+
+	return resourceNsxtDneKeyPolicyRead(d, m)
+}
+
+func resourceNsxtDneKeyPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.ServicesApi.DeleteDneKeyPolicy(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during DneKeyPolicy delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DneKeyPolicy %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
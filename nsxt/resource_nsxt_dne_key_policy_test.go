@@ -0,0 +1,169 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+func TestAccResourceNsxtDneKeyPolicy_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-dne-key-policy")
+	updateName := fmt.Sprintf("%s-update", name)
+	testResourceName := "nsxt_dne_key_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneKeyPolicyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneKeyPolicyCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneKeyPolicyExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "encrypt_algorithm", "AES_GCM_128"),
+					resource.TestCheckResourceAttr(testResourceName, "rekey_frequency", "86400"),
+					resource.TestCheckResourceAttr(testResourceName, "is_sys_default", "false"),
+				),
+			},
+			{
+				Config: testAccNSXDneKeyPolicyUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneKeyPolicyExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "encrypt_algorithm", "AES_GCM_256"),
+					resource.TestCheckResourceAttr(testResourceName, "rekey_frequency", "172800"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDneKeyPolicy_importBasic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-dne-key-policy")
+	testResourceName := "nsxt_dne_key_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneKeyPolicyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneKeyPolicyCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXDneKeyPolicyExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("DNE key policy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("DNE key policy resource ID not set in resources ")
+		}
+
+		keyPolicy, responseCode, err := nsxClient.ServicesApi.GetDneKeyPolicy(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving DNE key policy ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if DNE key policy %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == keyPolicy.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("DNE key policy %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXDneKeyPolicyCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_dne_key_policy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		keyPolicy, responseCode, err := nsxClient.ServicesApi.GetDneKeyPolicy(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving DNE key policy ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == keyPolicy.DisplayName {
+			return fmt.Errorf("DNE key policy %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXDneKeyPolicyCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_key_policy" "test" {
+  display_name      = "%s"
+  description       = "Acceptance Test"
+  encrypt_algorithm = "AES_GCM_128"
+  encrypt_type      = "ENCRYPTION"
+  mac_algorithm     = "HMAC_SHA256"
+  rekey_frequency   = 86400
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXDneKeyPolicyUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_key_policy" "test" {
+  display_name      = "%s"
+  description       = "Acceptance Test Update"
+  encrypt_algorithm = "AES_GCM_256"
+  encrypt_type      = "ENCRYPTION"
+  mac_algorithm     = "HMAC_SHA256"
+  rekey_frequency   = 172800
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName)
+}
@@ -0,0 +1,364 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"log"
+	"net/http"
+)
+
+// New DNE sections are placed on top, same as the NSX default
+const dneSectionInsertOperation string = "insert_top"
+
+// The delete API requires an operation parameter, which does not apply to
+// deletion. NSX only accepts the section placement values, so send its default.
+const dneSectionDeleteOperation string = "insert_top"
+
+func resourceNsxtDneSection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtDneSectionCreate,
+		Read:   resourceNsxtDneSectionRead,
+		Update: resourceNsxtDneSectionUpdate,
+		Delete: resourceNsxtDneSectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"is_default": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether a DNE section is default section or not",
+				Computed:    true,
+			},
+			"section_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Type of the rules which a section can contain. Only homogeneous sections are supported",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(firewallSectionTypeValues, false),
+			},
+			"rule": getDneRulesSchema(),
+		},
+	}
+}
+
+func getDneRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "List of DNE rules in the section. Only homogeneous rules are supported",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "ID of this resource",
+					Computed:    true,
+				},
+				"revision": getRevisionSchema(),
+				"description": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Description of this resource",
+					Optional:    true,
+				},
+				"display_name": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Defaults to ID if not set",
+					Optional:    true,
+				},
+				"action": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Type of protection the key policy of the rule provides",
+					Computed:    true,
+				},
+				"applied_to":  getResourceReferencesSetSchema(false, false, []string{"LogicalPort", "LogicalSwitch", "NSGroup"}, "List of objects where rule will be enforced. Null will be treated as any"),
+				"destination": getResourceReferencesSetSchema(false, false, []string{"IPSet", "LogicalPort", "LogicalSwitch", "NSGroup", "MACSet"}, "List of the destinations. Null will be treated as any"),
+				"disabled": &schema.Schema{
+					Type:        schema.TypeBool,
+					Description: "Flag to disable rule. Disabled will only be persisted but never provisioned/realized",
+					Optional:    true,
+				},
+				"ip_protocol": &schema.Schema{
+					Type:         schema.TypeString,
+					Description:  "Type of IP packet that should be matched while enforcing the rule (IPV4, IPV6, IPV4_IPV6)",
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(firewallRuleIPProtocolValues, false),
+				},
+				"key_policy_id": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "Identifier of the DNE key policy used by this rule",
+					Optional:    true,
+				},
+				"logged": &schema.Schema{
+					Type:        schema.TypeBool,
+					Description: "Flag to enable packet logging. Default is disabled",
+					Optional:    true,
+				},
+				"notes": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "User notes specific to the rule",
+					Optional:    true,
+				},
+				"rule_tag": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "User level field which will be printed in CLI and packet logs",
+					Optional:    true,
+				},
+				"source":  getResourceReferencesSetSchema(false, false, []string{"IPSet", "LogicalPort", "LogicalSwitch", "NSGroup", "MACSet"}, "List of sources. Null will be treated as any"),
+				"service": getResourceReferencesSchema(false, false, []string{"NSService", "NSServiceGroup"}, "List of the services. Null will be treated as any"),
+			},
+		},
+	}
+}
+
+func returnDneServicesResourceReferences(services []manager.DneService) []map[string]interface{} {
+	var servicesList []map[string]interface{}
+	for _, srv := range services {
+		elem := make(map[string]interface{})
+		elem["is_valid"] = srv.IsValid
+		elem["target_display_name"] = srv.TargetDisplayName
+		elem["target_id"] = srv.TargetId
+		elem["target_type"] = srv.TargetType
+		servicesList = append(servicesList, elem)
+	}
+	return servicesList
+}
+
+func getDneServicesResourceReferences(services []interface{}) []manager.DneService {
+	var servicesList []manager.DneService
+	for _, srv := range services {
+		data := srv.(map[string]interface{})
+		elem := manager.DneService{
+			IsValid:           data["is_valid"].(bool),
+			TargetDisplayName: data["target_display_name"].(string),
+			TargetId:          data["target_id"].(string),
+			TargetType:        data["target_type"].(string),
+		}
+		servicesList = append(servicesList, elem)
+	}
+	return servicesList
+}
+
+func setDneRulesInSchema(d *schema.ResourceData, rules []manager.DneRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := make(map[string]interface{})
+		elem["id"] = rule.Id
+		elem["display_name"] = rule.DisplayName
+		elem["description"] = rule.Description
+		elem["rule_tag"] = rule.RuleTag
+		elem["notes"] = rule.Comments
+		elem["logged"] = rule.Logged
+		elem["action"] = rule.Action
+		elem["ip_protocol"] = rule.IpProtocol
+		elem["disabled"] = rule.Disabled
+		elem["revision"] = rule.Revision
+		elem["key_policy_id"] = rule.KeyPolicyIdentifier
+		elem["source"] = returnResourceReferencesSet(rule.Sources)
+		elem["destination"] = returnResourceReferencesSet(rule.Destinations)
+		elem["service"] = returnDneServicesResourceReferences(rule.Services)
+		elem["applied_to"] = returnResourceReferencesSet(rule.AppliedTos)
+
+		rulesList = append(rulesList, elem)
+	}
+	err := d.Set("rule", rulesList)
+	return err
+}
+
+func getDneRulesFromSchema(d *schema.ResourceData) []manager.DneRule {
+	rules := d.Get("rule").([]interface{})
+	var ruleList []manager.DneRule
+	for _, rule := range rules {
+		data := rule.(map[string]interface{})
+		elem := manager.DneRule{
+			DisplayName:         data["display_name"].(string),
+			RuleTag:             data["rule_tag"].(string),
+			Comments:            data["notes"].(string),
+			Description:         data["description"].(string),
+			Logged:              data["logged"].(bool),
+			Disabled:            data["disabled"].(bool),
+			Revision:            int64(data["revision"].(int)),
+			IpProtocol:          data["ip_protocol"].(string),
+			KeyPolicyIdentifier: data["key_policy_id"].(string),
+			Sources:             getResourceReferences(data["source"].(*schema.Set).List()),
+			Destinations:        getResourceReferences(data["destination"].(*schema.Set).List()),
+			Services:            getDneServicesResourceReferences(data["service"].([]interface{})),
+			AppliedTos:          getResourceReferences(data["applied_to"].(*schema.Set).List()),
+		}
+
+		ruleList = append(ruleList, elem)
+	}
+	return ruleList
+}
+
+func resourceNsxtDneSectionCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	rules := getDneRulesFromSchema(d)
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	sectionType := d.Get("section_type").(string)
+
+	localVarOptionals := make(map[string]interface{})
+	var resp *http.Response
+	var err error
+	if len(rules) == 0 {
+		section := manager.DneSection{
+			Description: description,
+			DisplayName: displayName,
+			Tags:        tags,
+			SectionType: sectionType,
+		}
+		section, resp, err = nsxClient.ServicesApi.AddDneSection(nsxClient.Context, section, dneSectionInsertOperation, localVarOptionals)
+		d.SetId(section.Id)
+	} else {
+		section := manager.DneSectionRuleList{
+			Description: description,
+			DisplayName: displayName,
+			Tags:        tags,
+			SectionType: sectionType,
+			Rules:       rules,
+		}
+		section, resp, err = nsxClient.ServicesApi.AddDneSectionWithRulesCreateWithRules(nsxClient.Context, section, dneSectionInsertOperation, localVarOptionals)
+		d.SetId(section.Id)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error during DneSection create with rules: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during DneSection create with rules: %v", resp.StatusCode)
+	}
+
+	return resourceNsxtDneSectionRead(d, m)
+}
+
+func resourceNsxtDneSectionRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	section, resp, err := nsxClient.ServicesApi.GetDneSectionWithRulesListWithRules(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DneSection %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during DneSection %s read: %v", id, err)
+	}
+
+	d.Set("revision", section.Revision)
+	d.Set("description", section.Description)
+	d.Set("display_name", section.DisplayName)
+	d.Set("is_default", section.IsDefault)
+	d.Set("section_type", section.SectionType)
+	setTagsInSchema(d, section.Tags)
+	err = setDneRulesInSchema(d, section.Rules)
+	if err != nil {
+		return fmt.Errorf("Error during DneSection rules set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtDneSectionUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	nsxClient := m.(*api.APIClient)
+	rules := getDneRulesFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	sectionType := d.Get("section_type").(string)
+
+	var resp *http.Response
+	var err error
+	if len(rules) == 0 {
+		// The update with rules API fails for sections without rules
+		section := manager.DneSection{
+			Revision:    revision,
+			Description: description,
+			DisplayName: displayName,
+			Tags:        tags,
+			SectionType: sectionType,
+		}
+		_, resp, err = nsxClient.ServicesApi.UpdateDneSection(nsxClient.Context, id, section)
+		if err != nil || resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error during DneSection %s update: %v", id, err)
+		}
+
+		// Read the section, and delete all current rules from it
+		currSection, resp2, err2 := nsxClient.ServicesApi.GetDneSectionWithRulesListWithRules(nsxClient.Context, id)
+		if resp2 != nil && resp2.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("DneSection %s not found during update empty action", id)
+		}
+		if err2 != nil {
+			return fmt.Errorf("Error during DneSection %s update empty: cannot read the section: %v", id, err2)
+		}
+		for _, rule := range currSection.Rules {
+			nsxClient.ServicesApi.DeleteDneRule(nsxClient.Context, id, rule.Id)
+		}
+	} else {
+		section := manager.DneSectionRuleList{
+			Revision:    revision,
+			Description: description,
+			DisplayName: displayName,
+			Tags:        tags,
+			SectionType: sectionType,
+			Rules:       rules,
+		}
+		_, resp, err = nsxClient.ServicesApi.UpdateDneSectionWithRulesUpdateWithRules(nsxClient.Context, id, section)
+		if err != nil || resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error during DneSection %s update: %v", id, err)
+		}
+	}
+
+	return resourceNsxtDneSectionRead(d, m)
+}
+
+func resourceNsxtDneSectionDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id to delete")
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	localVarOptionals["cascade"] = true
+	resp, err := nsxClient.ServicesApi.DeleteDneSection(nsxClient.Context, id, dneSectionDeleteOperation, localVarOptionals)
+	if err != nil {
+		return fmt.Errorf("Error during DneSection %s delete: %v", id, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DneSection %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
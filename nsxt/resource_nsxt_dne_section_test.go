@@ -0,0 +1,280 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+func TestAccResourceNsxtDneSection_basic(t *testing.T) {
+	sectionName := fmt.Sprintf("test-nsx-dne-section-basic")
+	updateSectionName := fmt.Sprintf("%s-update", sectionName)
+	testResourceName := "nsxt_dne_section.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneSectionCheckDestroy(state, sectionName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneSectionCreateEmptyTemplate(sectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(sectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", sectionName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "section_type", "LAYER3"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "0"),
+				),
+			},
+			{
+				Config: testAccNSXDneSectionCreateEmptyTemplate(updateSectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(updateSectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateSectionName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDneSection_withRules(t *testing.T) {
+	sectionName := fmt.Sprintf("test-nsx-dne-section-rules")
+	updateSectionName := fmt.Sprintf("%s-update", sectionName)
+	testResourceName := "nsxt_dne_section.test"
+	ruleName := "rule1.0"
+	updatedRuleName := "rule1.1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneSectionCheckDestroy(state, sectionName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneSectionCreateTemplate(sectionName, ruleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(sectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", sectionName),
+					resource.TestCheckResourceAttr(testResourceName, "section_type", "LAYER3"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", ruleName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.source.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.service.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.key_policy_id"),
+				),
+			},
+			{
+				Config: testAccNSXDneSectionUpdateTemplate(updateSectionName, updatedRuleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(updateSectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateSectionName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", updatedRuleName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+				),
+			},
+			{
+				Config: testAccNSXDneSectionCreateEmptyTemplate(updateSectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(updateSectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDneSection_importWithRules(t *testing.T) {
+	sectionName := fmt.Sprintf("test-nsx-dne-section-import")
+	testResourceName := "nsxt_dne_section.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneSectionCheckDestroy(state, sectionName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneSectionCreateTemplate(sectionName, "rule1"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXDneSectionExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("DNE section resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("DNE section resource ID not set in resources ")
+		}
+
+		section, responseCode, err := nsxClient.ServicesApi.GetDneSection(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving DNE section ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if DNE section %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == section.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("DNE section %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXDneSectionCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_dne_section" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		section, responseCode, err := nsxClient.ServicesApi.GetDneSection(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving DNE section ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == section.DisplayName {
+			return fmt.Errorf("DNE section %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXDneSectionDependencies() string {
+	return fmt.Sprintf(`
+resource "nsxt_ns_group" "grp1" {
+  display_name = "grp1"
+}
+
+resource "nsxt_ns_group" "grp2" {
+  display_name = "grp2"
+}
+
+resource "nsxt_ip_protocol_ns_service" "test" {
+  protocol = "6"
+}
+
+resource "nsxt_dne_key_policy" "test" {
+  display_name      = "dne-section-test-key-policy"
+  encrypt_algorithm = "AES_GCM_128"
+  encrypt_type      = "ENCRYPTION"
+  mac_algorithm     = "HMAC_SHA256"
+}`)
+}
+
+func testAccNSXDneSectionCreateTemplate(name string, ruleName string) string {
+	return testAccNSXDneSectionDependencies() + fmt.Sprintf(`
+resource "nsxt_dne_section" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  section_type = "LAYER3"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  rule {
+    display_name  = "%s"
+    description   = "rule1"
+    logged        = true
+    ip_protocol   = "IPV4"
+    notes         = "test rule"
+    rule_tag      = "test rule tag"
+    key_policy_id = "${nsxt_dne_key_policy.test.id}"
+
+    source {
+      target_id   = "${nsxt_ns_group.grp1.id}"
+      target_type = "NSGroup"
+    }
+
+    destination {
+      target_id   = "${nsxt_ns_group.grp2.id}"
+      target_type = "NSGroup"
+    }
+
+    service {
+      target_id   = "${nsxt_ip_protocol_ns_service.test.id}"
+      target_type = "NSService"
+    }
+  }
+}`, name, ruleName)
+}
+
+func testAccNSXDneSectionUpdateTemplate(updatedName string, updatedRuleName string) string {
+	return testAccNSXDneSectionDependencies() + fmt.Sprintf(`
+resource "nsxt_dne_section" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test Update"
+  section_type = "LAYER3"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  rule {
+    display_name  = "%s"
+    description   = "rule1"
+    ip_protocol   = "IPV4"
+    key_policy_id = "${nsxt_dne_key_policy.test.id}"
+  }
+
+  rule {
+    display_name  = "rule2"
+    description   = "rule2"
+    ip_protocol   = "IPV6"
+    disabled      = true
+    key_policy_id = "${nsxt_dne_key_policy.test.id}"
+  }
+}`, updatedName, updatedRuleName)
+}
+
+func testAccNSXDneSectionCreateEmptyTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_section" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  section_type = "LAYER3"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
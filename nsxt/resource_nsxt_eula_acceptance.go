@@ -0,0 +1,75 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"log"
+)
+
+// The EULA cannot be declined once it has been accepted, so deleting this
+// resource only removes it from the state.
+const eulaAcceptanceID = "eula"
+
+func resourceNsxtEulaAcceptance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtEulaAcceptanceCreate,
+		Read:   resourceNsxtEulaAcceptanceRead,
+		Delete: resourceNsxtEulaAcceptanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accepted": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the EULA has been accepted",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtEulaAcceptanceCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+
+	_, err := nsxClient.LicensingApi.AcceptEULA(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during EulaAcceptance create: %v", err)
+	}
+
+	d.SetId(eulaAcceptanceID)
+
+	return resourceNsxtEulaAcceptanceRead(d, m)
+}
+
+func resourceNsxtEulaAcceptanceRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	acceptance, _, err := nsxClient.LicensingApi.GetEULAAcceptance(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during EulaAcceptance read: %v", err)
+	}
+
+	if !acceptance.Acceptance {
+		log.Printf("[DEBUG] EULA was not accepted")
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("accepted", acceptance.Acceptance)
+
+	return nil
+}
+
+func resourceNsxtEulaAcceptanceDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] EulaAcceptance %s removed from state, the EULA remains accepted", d.Id())
+	return nil
+}
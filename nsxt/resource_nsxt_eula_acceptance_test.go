@@ -0,0 +1,53 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+// The EULA cannot be declined, so there is nothing to check on destroy
+func TestAccResourceNsxtEulaAcceptance_basic(t *testing.T) {
+	testResourceName := "nsxt_eula_acceptance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXEulaAcceptanceTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "id", "eula"),
+					resource.TestCheckResourceAttr(testResourceName, "accepted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtEulaAcceptance_importBasic(t *testing.T) {
+	testResourceName := "nsxt_eula_acceptance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXEulaAcceptanceTemplate(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXEulaAcceptanceTemplate() string {
+	return `
+resource "nsxt_eula_acceptance" "test" {
+}`
+}
@@ -253,6 +253,33 @@ func TestAccResourceNsxtFirewallSection_withRulesAndTos(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtFirewallSection_layer2WithMacSets(t *testing.T) {
+	sectionName := fmt.Sprintf("test-nsx-firewall-section-layer2")
+	testResourceName := "nsxt_firewall_section.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXFirewallSectionCheckDestroy(state, sectionName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXFirewallSectionLayer2Template(sectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXFirewallSectionExists(sectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", sectionName),
+					resource.TestCheckResourceAttr(testResourceName, "section_type", "LAYER2"),
+					resource.TestCheckResourceAttr(testResourceName, "stateful", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.source.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.destination.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtFirewallSection_importBasic(t *testing.T) {
 	sectionName := fmt.Sprintf("test-nsx-firewall-section-basic")
 	testResourceName := "nsxt_firewall_section.test"
@@ -495,6 +522,42 @@ resource "nsxt_firewall_section" "test" {
 }`, updatedName, tags, tos, updatedRuleName)
 }
 
+func testAccNSXFirewallSectionLayer2Template(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_mac_set" "src" {
+  display_name  = "src"
+  mac_addresses = ["00:11:22:33:44:55"]
+}
+
+resource "nsxt_mac_set" "dst" {
+  display_name  = "dst"
+  mac_addresses = ["00:11:22:33:44:66", "00:11:22:33:44:77"]
+}
+
+resource "nsxt_firewall_section" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  section_type = "LAYER2"
+  stateful     = false
+
+  rule {
+    display_name = "l2_rule",
+    action       = "DROP",
+    direction    = "IN_OUT"
+
+    source {
+      target_id   = "${nsxt_mac_set.src.id}"
+      target_type = "MACSet"
+    }
+
+    destination {
+      target_id   = "${nsxt_mac_set.dst.id}"
+      target_type = "MACSet"
+    }
+  }
+}`, name)
+}
+
 func testAccNSXFirewallSectionCreateEmptyTemplate(name string, tags string, tos string) string {
 	return testAccNSXFirewallSectionNSGroups() + fmt.Sprintf(`
 resource "nsxt_firewall_section" "test" {
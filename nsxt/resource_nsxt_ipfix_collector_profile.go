@@ -0,0 +1,187 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/monitoring"
+	"log"
+	"net/http"
+)
+
+func resourceNsxtIpfixCollectorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtIpfixCollectorProfileCreate,
+		Read:   resourceNsxtIpfixCollectorProfileRead,
+		Update: resourceNsxtIpfixCollectorProfileUpdate,
+		Delete: resourceNsxtIpfixCollectorProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag":       getTagsSchema(),
+			"collector": getIpfixCollectorsSchema(true),
+		},
+	}
+}
+
+func getIpfixCollectorsSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "List of IPFIX collectors",
+		Required:    required,
+		Optional:    !required,
+		MaxItems:    4,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip_address": &schema.Schema{
+					Type:         schema.TypeString,
+					Description:  "IP address of the IPFIX collector",
+					Required:     true,
+					ValidateFunc: validateSingleIP(),
+				},
+				"port": &schema.Schema{
+					Type:         schema.TypeInt,
+					Description:  "Port of the IPFIX collector",
+					Optional:     true,
+					Default:      4739,
+					ValidateFunc: validation.IntBetween(0, 65535),
+				},
+			},
+		},
+	}
+}
+
+func getIpfixCollectorsFromSchema(d *schema.ResourceData) []monitoring.IpfixCollector {
+	var collectors []monitoring.IpfixCollector
+	for _, collector := range d.Get("collector").([]interface{}) {
+		data := collector.(map[string]interface{})
+		collectors = append(collectors, monitoring.IpfixCollector{
+			CollectorIpAddress: data["ip_address"].(string),
+			CollectorPort:      int32(data["port"].(int)),
+		})
+	}
+	return collectors
+}
+
+func setIpfixCollectorsInSchema(d *schema.ResourceData, collectors []monitoring.IpfixCollector) error {
+	var collectorList []map[string]interface{}
+	for _, collector := range collectors {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = collector.CollectorIpAddress
+		elem["port"] = collector.CollectorPort
+		collectorList = append(collectorList, elem)
+	}
+	return d.Set("collector", collectorList)
+}
+
+func getIpfixCollectorConfigFromSchema(d *schema.ResourceData) monitoring.IpfixCollectorConfig {
+	return monitoring.IpfixCollectorConfig{
+		Description: d.Get("description").(string),
+		DisplayName: d.Get("display_name").(string),
+		Tags:        getTagsFromSchema(d),
+		Collectors:  getIpfixCollectorsFromSchema(d),
+	}
+}
+
+func resourceNsxtIpfixCollectorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	collectorConfig := getIpfixCollectorConfigFromSchema(d)
+
+	collectorConfig, resp, err := nsxClient.OperationsApi.CreateIpfixCollectorConfig(nsxClient.Context, collectorConfig)
+
+	if err != nil {
+		return fmt.Errorf("Error during IpfixCollectorConfig create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during IpfixCollectorConfig create: %v", resp.StatusCode)
+	}
+	d.SetId(collectorConfig.Id)
+
+	return resourceNsxtIpfixCollectorProfileRead(d, m)
+}
+
+func resourceNsxtIpfixCollectorProfileRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	collectorConfig, resp, err := nsxClient.OperationsApi.GetIpfixCollectorConfig(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] IpfixCollectorConfig %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during IpfixCollectorConfig read: %v", err)
+	}
+
+	d.Set("revision", collectorConfig.Revision)
+	d.Set("description", collectorConfig.Description)
+	d.Set("display_name", collectorConfig.DisplayName)
+	setTagsInSchema(d, collectorConfig.Tags)
+	err = setIpfixCollectorsInSchema(d, collectorConfig.Collectors)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixCollectorConfig collectors set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtIpfixCollectorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	collectorConfig := getIpfixCollectorConfigFromSchema(d)
+	collectorConfig.Revision = int64(d.Get("revision").(int))
+
+	_, resp, err := nsxClient.OperationsApi.UpdateIpfixCollectorConfig(nsxClient.Context, id, collectorConfig)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during IpfixCollectorConfig update: %v", err)
+	}
+
+	return resourceNsxtIpfixCollectorProfileRead(d, m)
+}
+
+func resourceNsxtIpfixCollectorProfileDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.OperationsApi.DeleteIpfixCollectorConfig(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixCollectorConfig delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] IpfixCollectorConfig %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+func TestAccResourceNsxtIpfixCollectorProfile_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-ipfix-collector-profile")
+	updateName := fmt.Sprintf("%s-update", name)
+	testResourceName := "nsxt_ipfix_collector_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXIpfixCollectorProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXIpfixCollectorProfileCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXIpfixCollectorProfileExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.0.ip_address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.0.port", "4739"),
+				),
+			},
+			{
+				Config: testAccNSXIpfixCollectorProfileUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXIpfixCollectorProfileExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.1.ip_address", "2.2.2.2"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.1.port", "5000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtIpfixCollectorProfile_importBasic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-ipfix-collector-profile")
+	testResourceName := "nsxt_ipfix_collector_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXIpfixCollectorProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXIpfixCollectorProfileCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXIpfixCollectorProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("IPFIX collector profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("IPFIX collector profile resource ID not set in resources ")
+		}
+
+		profile, responseCode, err := nsxClient.OperationsApi.GetIpfixCollectorConfig(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving IPFIX collector profile ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if IPFIX collector profile %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == profile.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("IPFIX collector profile %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXIpfixCollectorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_ipfix_collector_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		profile, responseCode, err := nsxClient.OperationsApi.GetIpfixCollectorConfig(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving IPFIX collector profile ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == profile.DisplayName {
+			return fmt.Errorf("IPFIX collector profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXIpfixCollectorProfileCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_ipfix_collector_profile" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  collector {
+    ip_address = "1.1.1.1"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXIpfixCollectorProfileUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_ipfix_collector_profile" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test Update"
+
+  collector {
+    ip_address = "1.1.1.1"
+  }
+
+  collector {
+    ip_address = "2.2.2.2"
+    port       = 5000
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName)
+}
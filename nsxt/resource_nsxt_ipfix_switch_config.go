@@ -0,0 +1,216 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/monitoring"
+	"log"
+	"strconv"
+)
+
+const (
+	ipfixSwitchConfigDefaultTimeout           int32 = 300
+	ipfixSwitchConfigDefaultMaxFlows          int64 = 16384
+	ipfixSwitchConfigDefaultSampleProbability       = 0.1
+)
+
+// The global switch IPFIX configuration is a singleton, so its ID is fixed
+const ipfixSwitchConfigID = "ipfix_switch_config"
+
+// NSX stores the sample probability as a float32. Convert it through its
+// shortest decimal form, so that a value such as 0.3 is not read back as
+// 0.30000001192092896 and shown as a diff.
+func getIpfixSampleProbabilityForSchema(value float32) float64 {
+	probability, err := strconv.ParseFloat(strconv.FormatFloat(float64(value), 'f', -1, 32), 64)
+	if err != nil {
+		return float64(value)
+	}
+	return probability
+}
+
+func resourceNsxtIpfixSwitchConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtIpfixSwitchConfigCreate,
+		Read:   resourceNsxtIpfixSwitchConfigRead,
+		Update: resourceNsxtIpfixSwitchConfigUpdate,
+		Delete: resourceNsxtIpfixSwitchConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Enabled status of the IPFIX export",
+				Optional:    true,
+				Default:     true,
+			},
+			"collector": getIpfixCollectorsSchema(false),
+			"active_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "The time in seconds after which a flow is expired even if more packets matching this flow are received",
+				Optional:     true,
+				Default:      int(ipfixSwitchConfigDefaultTimeout),
+				ValidateFunc: validation.IntBetween(60, 3600),
+			},
+			"idle_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "The time in seconds after which a flow is expired if no more packets matching this flow are received",
+				Optional:     true,
+				Default:      int(ipfixSwitchConfigDefaultTimeout),
+				ValidateFunc: validation.IntBetween(60, 3600),
+			},
+			"max_flows": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The maximum number of flow entries in each exporter flow cache",
+				Optional:    true,
+				Default:     int(ipfixSwitchConfigDefaultMaxFlows),
+			},
+			"observation_domain_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "An identifier that is unique to the exporting process and used to meter the flows",
+				Optional:    true,
+				Computed:    true,
+			},
+			"packet_sample_probability": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Description:  "The probability in percentage that a packet is sampled, in range 0-100",
+				Optional:     true,
+				Default:      float64(ipfixSwitchConfigDefaultSampleProbability),
+				ValidateFunc: validateFloatBetween(0, 100),
+			},
+		},
+	}
+}
+
+func resourceNsxtIpfixSwitchConfigApply(d *schema.ResourceData, nsxClient *api.APIClient, config monitoring.IpfixObsPointConfig) error {
+	config.Description = d.Get("description").(string)
+	config.DisplayName = d.Get("display_name").(string)
+	config.Tags = getTagsFromSchema(d)
+	config.Enabled = d.Get("enabled").(bool)
+	config.Collectors = getIpfixCollectorsFromSchema(d)
+	config.ActiveTimeout = int32(d.Get("active_timeout").(int))
+	config.IdleTimeout = int32(d.Get("idle_timeout").(int))
+	config.MaxFlows = int64(d.Get("max_flows").(int))
+	if observationDomainID := d.Get("observation_domain_id").(int); observationDomainID != 0 {
+		config.ObservationDomainId = int64(observationDomainID)
+	}
+	config.PacketSampleProbability = float32(d.Get("packet_sample_probability").(float64))
+
+	_, _, err := nsxClient.TroubleshootingAndMonitoringApi.UpdateSwitchIpfixConfig(nsxClient.Context, config)
+	return err
+}
+
+func resourceNsxtIpfixSwitchConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+
+	// Get the current configuration in order to use its revision
+	config, _, err := nsxClient.TroubleshootingAndMonitoringApi.GetSwitchIpfixConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixObsPointConfig read: %v", err)
+	}
+
+	err = resourceNsxtIpfixSwitchConfigApply(d, nsxClient, config)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixObsPointConfig create: %v", err)
+	}
+
+	d.SetId(ipfixSwitchConfigID)
+
+	return resourceNsxtIpfixSwitchConfigRead(d, m)
+}
+
+func resourceNsxtIpfixSwitchConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	config, _, err := nsxClient.TroubleshootingAndMonitoringApi.GetSwitchIpfixConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixObsPointConfig read: %v", err)
+	}
+
+	d.Set("revision", config.Revision)
+	d.Set("description", config.Description)
+	d.Set("display_name", config.DisplayName)
+	setTagsInSchema(d, config.Tags)
+	d.Set("enabled", config.Enabled)
+	err = setIpfixCollectorsInSchema(d, config.Collectors)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixObsPointConfig collectors set in schema: %v", err)
+	}
+	d.Set("active_timeout", config.ActiveTimeout)
+	d.Set("idle_timeout", config.IdleTimeout)
+	d.Set("max_flows", config.MaxFlows)
+	d.Set("observation_domain_id", config.ObservationDomainId)
+	d.Set("packet_sample_probability", getIpfixSampleProbabilityForSchema(config.PacketSampleProbability))
+
+	return nil
+}
+
+func resourceNsxtIpfixSwitchConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	config, _, err := nsxClient.TroubleshootingAndMonitoringApi.GetSwitchIpfixConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixObsPointConfig read: %v", err)
+	}
+	config.Revision = int64(d.Get("revision").(int))
+	err = resourceNsxtIpfixSwitchConfigApply(d, nsxClient, config)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixObsPointConfig update: %v", err)
+	}
+
+	return resourceNsxtIpfixSwitchConfigRead(d, m)
+}
+
+func resourceNsxtIpfixSwitchConfigDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	// Disable the export and restore the default values
+	config, _, err := nsxClient.TroubleshootingAndMonitoringApi.GetSwitchIpfixConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixObsPointConfig read: %v", err)
+	}
+	config.Enabled = false
+	config.Collectors = nil
+	config.ActiveTimeout = ipfixSwitchConfigDefaultTimeout
+	config.IdleTimeout = ipfixSwitchConfigDefaultTimeout
+	config.MaxFlows = ipfixSwitchConfigDefaultMaxFlows
+	config.PacketSampleProbability = ipfixSwitchConfigDefaultSampleProbability
+	_, _, err = nsxClient.TroubleshootingAndMonitoringApi.UpdateSwitchIpfixConfig(nsxClient.Context, config)
+	if err != nil {
+		return fmt.Errorf("Error during IpfixObsPointConfig delete: %v", err)
+	}
+
+	log.Printf("[DEBUG] IpfixObsPointConfig %s restored to defaults", id)
+	return nil
+}
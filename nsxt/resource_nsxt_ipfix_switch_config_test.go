@@ -0,0 +1,120 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"testing"
+)
+
+func TestAccResourceNsxtIpfixSwitchConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_ipfix_switch_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXIpfixSwitchConfigCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXIpfixSwitchConfigTemplate(120, "50"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXIpfixSwitchConfigCheck(true, 120),
+					resource.TestCheckResourceAttr(testResourceName, "id", "ipfix_switch_config"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "active_timeout", "120"),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", "120"),
+					resource.TestCheckResourceAttr(testResourceName, "packet_sample_probability", "50"),
+				),
+			},
+			{
+				Config: testAccNSXIpfixSwitchConfigTemplate(600, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXIpfixSwitchConfigCheck(true, 600),
+					resource.TestCheckResourceAttr(testResourceName, "active_timeout", "600"),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", "600"),
+					resource.TestCheckResourceAttr(testResourceName, "packet_sample_probability", "10"),
+				),
+			},
+			{
+				// Not exactly representable as a float32, and must not show a diff
+				Config: testAccNSXIpfixSwitchConfigTemplate(600, "0.3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXIpfixSwitchConfigCheck(true, 600),
+					resource.TestCheckResourceAttr(testResourceName, "packet_sample_probability", "0.3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtIpfixSwitchConfig_importBasic(t *testing.T) {
+	testResourceName := "nsxt_ipfix_switch_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXIpfixSwitchConfigCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXIpfixSwitchConfigTemplate(120, "50"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXIpfixSwitchConfigCheck(enabled bool, timeout int32) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+		config, _, err := nsxClient.TroubleshootingAndMonitoringApi.GetSwitchIpfixConfig(nsxClient.Context)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving switch IPFIX configuration. Error: %v", err)
+		}
+
+		if config.Enabled != enabled || config.ActiveTimeout != timeout || config.IdleTimeout != timeout {
+			return fmt.Errorf("Switch IPFIX configuration was not updated")
+		}
+		return nil
+	}
+}
+
+func testAccNSXIpfixSwitchConfigCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	config, _, err := nsxClient.TroubleshootingAndMonitoringApi.GetSwitchIpfixConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while retrieving switch IPFIX configuration. Error: %v", err)
+	}
+
+	if config.Enabled || len(config.Collectors) > 0 || config.ActiveTimeout != ipfixSwitchConfigDefaultTimeout {
+		return fmt.Errorf("Switch IPFIX configuration was not restored to defaults")
+	}
+	return nil
+}
+
+func testAccNSXIpfixSwitchConfigTemplate(timeout int, sampleProbability string) string {
+	return fmt.Sprintf(`
+resource "nsxt_ipfix_switch_config" "test" {
+  enabled                   = true
+  active_timeout            = %d
+  idle_timeout              = %d
+  packet_sample_probability = %s
+
+  collector {
+    ip_address = "1.1.1.1"
+    port       = 4739
+  }
+}`, timeout, timeout, sampleProbability)
+}
@@ -21,6 +21,7 @@ func resourceNsxtLbHTTPVirtualServer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceNsxtLbHTTPVirtualServerCustomizeDiff,
 
 		// TODO: add client/server_tcp_profile_id when available
 		Schema: map[string]*schema.Schema{
@@ -95,7 +96,7 @@ func resourceNsxtLbHTTPVirtualServer() *schema.Resource {
 			},
 			"rule_ids": &schema.Schema{
 				Type:        schema.TypeList,
-				Description: "Customization of load balancing behavior using match/action rules",
+				Description: "Ordered list of match/action rules that customize load balancing behavior",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -111,6 +112,62 @@ func resourceNsxtLbHTTPVirtualServer() *schema.Resource {
 	}
 }
 
+// Rule phases which can be used with each type of application profile
+var lbRulePhasesPerApplicationProfile = map[string][]string{
+	"LbHttpProfile": []string{"HTTP_REQUEST_REWRITE", "HTTP_FORWARDING", "HTTP_RESPONSE_REWRITE"},
+}
+
+func isLbRulePhaseCompatible(profileType string, phase string) bool {
+	for _, compatiblePhase := range lbRulePhasesPerApplicationProfile[profileType] {
+		if phase == compatiblePhase {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceNsxtLbHTTPVirtualServerCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("application_profile_id") && !d.HasChange("rule_ids") {
+		return nil
+	}
+
+	// Values which are not known yet at plan time cannot be checked. The SDK has
+	// no NewValueKnown for diffs, but GetOk reports unknown values as not set.
+	profileID, ok := d.GetOk("application_profile_id")
+	if !ok {
+		return nil
+	}
+	ruleIDList, ok := d.GetOk("rule_ids")
+	if !ok {
+		return nil
+	}
+	var ruleIDs []string
+	for i := range ruleIDList.([]interface{}) {
+		ruleID, ok := d.GetOk(fmt.Sprintf("rule_ids.%d", i))
+		if ok {
+			ruleIDs = append(ruleIDs, ruleID.(string))
+		}
+	}
+
+	nsxClient := m.(*api.APIClient)
+	profile, _, err := nsxClient.ServicesApi.ReadLoadBalancerApplicationProfile(nsxClient.Context, profileID.(string))
+	if err != nil {
+		return fmt.Errorf("Error while reading application profile %s: %v", profileID, err)
+	}
+
+	for _, ruleID := range ruleIDs {
+		rule, _, err := nsxClient.ServicesApi.ReadLoadBalancerRule(nsxClient.Context, ruleID)
+		if err != nil {
+			return fmt.Errorf("Error while reading rule %s: %v", ruleID, err)
+		}
+		if !isLbRulePhaseCompatible(profile.ResourceType, rule.Phase) {
+			return fmt.Errorf("Rule %s with phase %s is not compatible with application profile %s of type %s", ruleID, rule.Phase, profileID, profile.ResourceType)
+		}
+	}
+
+	return nil
+}
+
 func getLbClientSSLBindingSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
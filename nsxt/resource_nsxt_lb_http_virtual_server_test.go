@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/vmware/go-vmware-nsxt"
 	"net/http"
+	"regexp"
 	"testing"
 )
 
@@ -95,6 +96,8 @@ func TestAccResourceNsxtLbHttpVirtualServer_withRules(t *testing.T) {
 					resource.TestCheckResourceAttr(fullName, "ip_address", "1.1.1.1"),
 					resource.TestCheckResourceAttr(fullName, "port", "443"),
 					resource.TestCheckResourceAttr(fullName, "rule_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(fullName, "rule_ids.0", "nsxt_lb_http_request_rewrite_rule."+rule1, "id"),
+					resource.TestCheckResourceAttrPair(fullName, "rule_ids.1", "nsxt_lb_http_request_rewrite_rule."+rule2, "id"),
 				),
 			},
 			{
@@ -104,12 +107,36 @@ func TestAccResourceNsxtLbHttpVirtualServer_withRules(t *testing.T) {
 					resource.TestCheckResourceAttr(fullName, "ip_address", "1.1.1.1"),
 					resource.TestCheckResourceAttr(fullName, "port", "443"),
 					resource.TestCheckResourceAttr(fullName, "rule_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(fullName, "rule_ids.0", "nsxt_lb_http_request_rewrite_rule."+updatedRule1, "id"),
+					resource.TestCheckResourceAttrPair(fullName, "rule_ids.1", "nsxt_lb_http_request_rewrite_rule."+updatedRule2, "id"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccResourceNsxtLbHttpVirtualServer_withIncompatibleRules(t *testing.T) {
+	name := "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLbHTTPVirtualServerCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				// Create the profile and the rule first, so their IDs are known at plan time
+				Config: testAccNSXLbHTTPVirtualServerIncompatibleRulesPrerequisites(),
+			},
+			{
+				Config:      testAccNSXLbHTTPVirtualServerCreateTemplateWithIncompatibleRules(),
+				ExpectError: regexp.MustCompile("is not compatible with application profile"),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtLbHttpVirtualServer_withSSL(t *testing.T) {
 	name := "test"
 	fullName := "nsxt_lb_http_virtual_server.test"
@@ -206,6 +233,29 @@ func TestAccResourceNsxtLbHttpVirtualServer_importBasic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtLbHttpVirtualServer_importWithRules(t *testing.T) {
+	name := "test"
+	resourceName := "nsxt_lb_http_virtual_server.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLbHTTPVirtualServerCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLbHTTPVirtualServerCreateTemplateWithRules("rule3", "rule1"),
+			},
+			{
+				// Rule order is verified along with the other attributes
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccNSXLbHTTPVirtualServerExists(displayName string, resourceName string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
@@ -358,6 +408,39 @@ resource "nsxt_lb_http_virtual_server" "test" {
 `, rule1, rule2)
 }
 
+func testAccNSXLbHTTPVirtualServerIncompatibleRulesPrerequisites() string {
+	return `
+resource "nsxt_lb_fast_tcp_application_profile" "test" {
+  display_name = "lb virtual server test"
+}
+
+resource "nsxt_lb_http_forwarding_rule" "test" {
+  display_name = "lb virtual server test"
+  uri_condition {
+    uri        = "/hello"
+    match_type = "STARTS_WITH"
+  }
+
+  http_reject_action {
+    reply_status  = "500"
+    reply_message = "test"
+  }
+}
+`
+}
+
+func testAccNSXLbHTTPVirtualServerCreateTemplateWithIncompatibleRules() string {
+	return testAccNSXLbHTTPVirtualServerIncompatibleRulesPrerequisites() + `
+resource "nsxt_lb_http_virtual_server" "test" {
+  display_name           = "test"
+  application_profile_id = "${nsxt_lb_fast_tcp_application_profile.test.id}"
+  ip_address             = "1.1.1.1"
+  port                   = "443"
+  rule_ids               = ["${nsxt_lb_http_forwarding_rule.test.id}"]
+}
+`
+}
+
 func testAccNSXLbHTTPVirtualServerCreateTemplateWithSSL(depth string) string {
 	return fmt.Sprintf(`
 resource "nsxt_lb_http_application_profile" "test" {
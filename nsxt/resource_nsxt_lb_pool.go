@@ -12,6 +12,7 @@ import (
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 	"log"
 	"net/http"
+	"time"
 )
 
 var poolAlgTypeValues = []string{"ROUND_ROBIN", "WEIGHTED_ROUND_ROBIN", "LEAST_CONNECTION", "WEIGHTED_LEAST_CONNECTION", "IP_HASH"}
@@ -81,6 +82,19 @@ func resourceNsxtLbPool() *schema.Resource {
 			"snat_translation": getSnatTranslationSchema(),
 			"member":           getPoolMembersSchema(),
 			"member_group":     getPoolMemberGroupSchema(),
+			"ignore_external_members": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Ignore pool members which are not configured in this resource, such as the ones managed by nsxt_lb_pool_member",
+				Optional:    true,
+				Default:     false,
+			},
+			"drain_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Time (in seconds) to wait after setting members being removed to GRACEFUL_DISABLED, before removing them. Drain is disabled when 0",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 	}
 }
@@ -310,6 +324,75 @@ func getPoolMembersFromSchema(d *schema.ResourceData) []loadbalancer.PoolMember
 	return memberList
 }
 
+func getPoolMemberKeys(members []interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	for _, member := range members {
+		data := member.(map[string]interface{})
+		keys[getLbPoolMemberID(data["ip_address"].(string), data["port"].(string))] = true
+	}
+	return keys
+}
+
+// filterPoolMembers returns only the members configured in this resource
+func filterPoolMembers(d *schema.ResourceData, members []loadbalancer.PoolMember) []loadbalancer.PoolMember {
+	keys := getPoolMemberKeys(d.Get("member").([]interface{}))
+	var filtered []loadbalancer.PoolMember
+	for _, member := range members {
+		if keys[getLbPoolMemberID(member.IpAddress, member.Port)] {
+			filtered = append(filtered, member)
+		}
+	}
+	return filtered
+}
+
+// getExternalPoolMembers returns the current members of the pool which were
+// not configured in this resource before the update
+func getExternalPoolMembers(d *schema.ResourceData, nsxClient *api.APIClient, id string) ([]loadbalancer.PoolMember, error) {
+	lbPool, _, err := nsxClient.ServicesApi.ReadLoadBalancerPool(nsxClient.Context, id)
+	if err != nil {
+		return nil, err
+	}
+
+	oldMembers, newMembers := d.GetChange("member")
+	oldKeys := getPoolMemberKeys(oldMembers.([]interface{}))
+	newKeys := getPoolMemberKeys(newMembers.([]interface{}))
+	var external []loadbalancer.PoolMember
+	for _, member := range lbPool.Members {
+		key := getLbPoolMemberID(member.IpAddress, member.Port)
+		if !oldKeys[key] && !newKeys[key] {
+			external = append(external, member)
+		}
+	}
+	return external, nil
+}
+
+// drainPoolMembers sets the given pool members to GRACEFUL_DISABLED and waits
+// for the drain timeout, so that live connections are not dropped when the
+// members are removed. The SDK has no pool status API to poll the remaining
+// connections, so this is a fixed sleep. The revision of the updated pool is
+// returned.
+func drainPoolMembers(nsxClient *api.APIClient, id string, keys map[string]bool, timeout int) (int32, error) {
+	drained := 0
+	lbPool, _, err := updateLbPoolMembers(nsxClient, id, func(members []loadbalancer.PoolMember) ([]loadbalancer.PoolMember, error) {
+		for i, member := range members {
+			if keys[getLbPoolMemberID(member.IpAddress, member.Port)] && member.AdminState == "ENABLED" {
+				members[i].AdminState = "GRACEFUL_DISABLED"
+				drained++
+			}
+		}
+		return members, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if drained > 0 {
+		log.Printf("[DEBUG] Draining %d members of LbPool %s for %d seconds", drained, id, timeout)
+		time.Sleep(time.Duration(timeout) * time.Second)
+	}
+	return lbPool.Revision, nil
+}
+
 func setPoolGroupMemberInSchema(d *schema.ResourceData, groupMember *loadbalancer.PoolMemberGroup) error {
 	var groupMembersList []map[string]interface{}
 	if groupMember != nil {
@@ -427,7 +510,11 @@ func resourceNsxtLbPoolRead(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("passive_monitor_id", lbPool.PassiveMonitorId)
 	d.Set("algorithm", lbPool.Algorithm)
-	err = setPoolMembersInSchema(d, lbPool.Members)
+	members := lbPool.Members
+	if d.Get("ignore_external_members").(bool) {
+		members = filterPoolMembers(d, members)
+	}
+	err = setPoolMembersInSchema(d, members)
 	if err != nil {
 		return fmt.Errorf("Error during LB Pool members set in schema: %v", err)
 	}
@@ -466,6 +553,32 @@ func resourceNsxtLbPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	snatTranslation := getSnatTranslationFromSchema(d)
 	tcpMultiplexingEnabled := d.Get("tcp_multiplexing_enabled").(bool)
 	tcpMultiplexingNumber := int64(d.Get("tcp_multiplexing_number").(int))
+	drainTimeout := d.Get("drain_timeout").(int)
+	if drainTimeout > 0 && d.HasChange("member") {
+		oldMembers, newMembers := d.GetChange("member")
+		newKeys := getPoolMemberKeys(newMembers.([]interface{}))
+		removedKeys := make(map[string]bool)
+		for key := range getPoolMemberKeys(oldMembers.([]interface{})) {
+			if !newKeys[key] {
+				removedKeys[key] = true
+			}
+		}
+		if len(removedKeys) > 0 {
+			var err error
+			revision, err = drainPoolMembers(nsxClient, id, removedKeys, drainTimeout)
+			if err != nil {
+				return fmt.Errorf("Error during LbPool %s members drain: %v", id, err)
+			}
+		}
+	}
+	if d.Get("ignore_external_members").(bool) {
+		// Keep the members added outside of this resource
+		externalMembers, err := getExternalPoolMembers(d, nsxClient, id)
+		if err != nil {
+			return fmt.Errorf("Error during LbPool %s read of external members: %v", id, err)
+		}
+		members = append(members, externalMembers...)
+	}
 	lbPool := loadbalancer.LbPool{
 		Revision:               revision,
 		Description:            description,
@@ -498,6 +611,15 @@ func resourceNsxtLbPoolDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error obtaining logical object id")
 	}
 
+	drainTimeout := d.Get("drain_timeout").(int)
+	if drainTimeout > 0 {
+		keys := getPoolMemberKeys(d.Get("member").([]interface{}))
+		_, err := drainPoolMembers(nsxClient, id, keys, drainTimeout)
+		if err != nil {
+			return fmt.Errorf("Error during LbPool %s members drain: %v", id, err)
+		}
+	}
+
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerPool(nsxClient.Context, id)
 	if err != nil {
 		return fmt.Errorf("Error during LbPool delete: %v", err)
@@ -0,0 +1,270 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/loadbalancer"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// The SDK has no pool member API, so members are modified by a read-modify-write
+// of the whole pool. Changes made by this provider are serialized, and the pool
+// revision makes NSX reject writes based on a stale read by other clients.
+var lbPoolMemberMutex sync.Mutex
+
+func resourceNsxtLbPoolMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtLbPoolMemberCreate,
+		Read:   resourceNsxtLbPoolMemberRead,
+		Update: resourceNsxtLbPoolMemberUpdate,
+		Delete: resourceNsxtLbPoolMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtLbPoolMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pool_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the load balancer pool this member is added to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Pool member name",
+				Optional:    true,
+				Computed:    true,
+			},
+			"admin_state": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Member admin state",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(memberAdminStateTypeValues, false),
+				Default:      "ENABLED",
+			},
+			"backup_member": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether this is a backup pool member",
+				Optional:    true,
+				Default:     false,
+			},
+			"ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Pool member IP address",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"max_concurrent_connections": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "To ensure members are not overloaded, connections to a member can be capped by the load balancer. When a member reaches this limit, it is skipped during server selection. If it is not specified, it means that connections are unlimited",
+				Optional:    true,
+			},
+			"port": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "If port is specified, all connections will be sent to this port. If unset, the same port the client connected to will be used",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSinglePort(),
+			},
+			"weight": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Pool member weight is used for WEIGHTED_ROUND_ROBIN balancing algorithm. The weight value would be ignored in other algorithms",
+				Optional:    true,
+				Default:     1,
+			},
+		},
+	}
+}
+
+func getLbPoolMemberID(ipAddress string, port string) string {
+	if port == "" {
+		return ipAddress
+	}
+	return net.JoinHostPort(ipAddress, port)
+}
+
+func getLbPoolMemberFromSchema(d *schema.ResourceData) loadbalancer.PoolMember {
+	return loadbalancer.PoolMember{
+		DisplayName:              d.Get("display_name").(string),
+		AdminState:               d.Get("admin_state").(string),
+		BackupMember:             d.Get("backup_member").(bool),
+		IpAddress:                d.Get("ip_address").(string),
+		MaxConcurrentConnections: int64(d.Get("max_concurrent_connections").(int)),
+		Port:                     d.Get("port").(string),
+		Weight:                   int64(d.Get("weight").(int)),
+	}
+}
+
+func findLbPoolMember(members []loadbalancer.PoolMember, ipAddress string, port string) int {
+	for i, member := range members {
+		if member.IpAddress == ipAddress && member.Port == port {
+			return i
+		}
+	}
+	return -1
+}
+
+// updateLbPoolMembers reads the pool, lets the callback modify its member
+// list and writes the pool back
+func updateLbPoolMembers(nsxClient *api.APIClient, poolID string, modify func([]loadbalancer.PoolMember) ([]loadbalancer.PoolMember, error)) (loadbalancer.LbPool, *http.Response, error) {
+	lbPoolMemberMutex.Lock()
+	defer lbPoolMemberMutex.Unlock()
+
+	lbPool, resp, err := nsxClient.ServicesApi.ReadLoadBalancerPool(nsxClient.Context, poolID)
+	if err != nil {
+		return lbPool, resp, err
+	}
+
+	members, err := modify(lbPool.Members)
+	if err != nil {
+		return lbPool, nil, err
+	}
+	lbPool.Members = members
+
+	return nsxClient.ServicesApi.UpdateLoadBalancerPool(nsxClient.Context, poolID, lbPool)
+}
+
+func resourceNsxtLbPoolMemberCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	poolID := d.Get("pool_id").(string)
+	member := getLbPoolMemberFromSchema(d)
+
+	_, _, err := updateLbPoolMembers(nsxClient, poolID, func(members []loadbalancer.PoolMember) ([]loadbalancer.PoolMember, error) {
+		if findLbPoolMember(members, member.IpAddress, member.Port) >= 0 {
+			return nil, fmt.Errorf("Member %s already exists", getLbPoolMemberID(member.IpAddress, member.Port))
+		}
+		return append(members, member), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error during LbPool %s member add: %v", poolID, err)
+	}
+	d.SetId(getLbPoolMemberID(member.IpAddress, member.Port))
+
+	return resourceNsxtLbPoolMemberRead(d, m)
+}
+
+func resourceNsxtLbPoolMemberRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	poolID := d.Get("pool_id").(string)
+	if id == "" || poolID == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	lbPool, resp, err := nsxClient.ServicesApi.ReadLoadBalancerPool(nsxClient.Context, poolID)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] LbPool %s not found", poolID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during LbPool %s read: %v", poolID, err)
+	}
+
+	i := findLbPoolMember(lbPool.Members, d.Get("ip_address").(string), d.Get("port").(string))
+	if i < 0 {
+		log.Printf("[DEBUG] Member %s not found in LbPool %s", id, poolID)
+		d.SetId("")
+		return nil
+	}
+
+	member := lbPool.Members[i]
+	d.Set("pool_id", poolID)
+	d.Set("display_name", member.DisplayName)
+	d.Set("admin_state", member.AdminState)
+	d.Set("backup_member", member.BackupMember)
+	d.Set("ip_address", member.IpAddress)
+	d.Set("max_concurrent_connections", member.MaxConcurrentConnections)
+	d.Set("port", member.Port)
+	d.Set("weight", member.Weight)
+
+	return nil
+}
+
+func resourceNsxtLbPoolMemberUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	poolID := d.Get("pool_id").(string)
+	if id == "" || poolID == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	member := getLbPoolMemberFromSchema(d)
+	_, resp, err := updateLbPoolMembers(nsxClient, poolID, func(members []loadbalancer.PoolMember) ([]loadbalancer.PoolMember, error) {
+		i := findLbPoolMember(members, member.IpAddress, member.Port)
+		if i < 0 {
+			return nil, fmt.Errorf("Member %s not found", id)
+		}
+		members[i] = member
+		return members, nil
+	})
+
+	if err != nil || (resp != nil && resp.StatusCode == http.StatusNotFound) {
+		return fmt.Errorf("Error during LbPool %s member update: %v", poolID, err)
+	}
+
+	return resourceNsxtLbPoolMemberRead(d, m)
+}
+
+func resourceNsxtLbPoolMemberDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	poolID := d.Get("pool_id").(string)
+	if id == "" || poolID == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	ipAddress := d.Get("ip_address").(string)
+	port := d.Get("port").(string)
+	_, resp, err := updateLbPoolMembers(nsxClient, poolID, func(members []loadbalancer.PoolMember) ([]loadbalancer.PoolMember, error) {
+		i := findLbPoolMember(members, ipAddress, port)
+		if i < 0 {
+			log.Printf("[DEBUG] Member %s not found in LbPool %s", id, poolID)
+			return members, nil
+		}
+		return append(members[:i], members[i+1:]...), nil
+	})
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] LbPool %s not found", poolID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during LbPool %s member delete: %v", poolID, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtLbPoolMemberImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.SplitN(importID, "/", 2)
+	if len(s) != 2 {
+		return nil, fmt.Errorf("Please provide <pool-id>/<ip-address>[:<port>] as an input")
+	}
+
+	ipAddress, port, err := net.SplitHostPort(s[1])
+	if err != nil {
+		// No port given
+		ipAddress = s[1]
+		port = ""
+	}
+
+	d.SetId(getLbPoolMemberID(ipAddress, port))
+	d.Set("pool_id", s[0])
+	d.Set("ip_address", ipAddress)
+	d.Set("port", port)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,171 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+var testNsxtLbPoolMemberResourceName = "nsxt_lb_pool_member.test"
+
+func TestAccResourceNsxtLbPoolMember_basic(t *testing.T) {
+	poolName := fmt.Sprintf("test-nsx-lb-pool-shared")
+	testResourceName := testNsxtLbPoolMemberResourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLbPoolMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLbPoolMemberCreateTemplate(poolName, "ENABLED", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXLbPoolMemberExists(testResourceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "pool_id"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", "1.1.1.2"),
+					resource.TestCheckResourceAttr(testResourceName, "port", "80"),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", "ENABLED"),
+					resource.TestCheckResourceAttr(testResourceName, "weight", "1"),
+					resource.TestCheckResourceAttr("nsxt_lb_pool.shared", "member.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXLbPoolMemberCreateTemplate(poolName, "DISABLED", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXLbPoolMemberExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", "DISABLED"),
+					resource.TestCheckResourceAttr(testResourceName, "weight", "5"),
+					resource.TestCheckResourceAttr("nsxt_lb_pool.shared", "member.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtLbPoolMember_importBasic(t *testing.T) {
+	poolName := fmt.Sprintf("test-nsx-lb-pool-shared")
+	testResourceName := testNsxtLbPoolMemberResourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLbPoolMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLbPoolMemberCreateTemplate(poolName, "ENABLED", 1),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXLbPoolMemberImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNSXLbPoolMemberImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[testNsxtLbPoolMemberResourceName]
+	if !ok {
+		return "", fmt.Errorf("LB pool member %s not found in resources", testNsxtLbPoolMemberResourceName)
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("LB pool member resource ID not set in resources")
+	}
+	poolID := rs.Primary.Attributes["pool_id"]
+	if poolID == "" {
+		return "", fmt.Errorf("LB pool member pool_id not set in resources")
+	}
+	return fmt.Sprintf("%s/%s", poolID, resourceID), nil
+}
+
+func testAccNSXLbPoolMemberIsInPool(poolID string, ipAddress string, port string) (bool, error) {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	lbPool, responseCode, err := nsxClient.ServicesApi.ReadLoadBalancerPool(nsxClient.Context, poolID)
+	if err != nil {
+		if responseCode != nil && responseCode.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error while retrieving LB pool ID %s. Error: %v", poolID, err)
+	}
+
+	return findLbPoolMember(lbPool.Members, ipAddress, port) >= 0, nil
+}
+
+func testAccNSXLbPoolMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("LB pool member resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("LB pool member resource ID not set in resources ")
+		}
+
+		poolID := rs.Primary.Attributes["pool_id"]
+		found, err := testAccNSXLbPoolMemberIsInPool(poolID, rs.Primary.Attributes["ip_address"], rs.Primary.Attributes["port"])
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("LB pool member %s wasn't found in LB pool %s", resourceID, poolID)
+		}
+		return nil
+	}
+}
+
+func testAccNSXLbPoolMemberCheckDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_lb_pool_member" {
+			continue
+		}
+
+		resourceID := rs.Primary.ID
+		poolID := rs.Primary.Attributes["pool_id"]
+		found, err := testAccNSXLbPoolMemberIsInPool(poolID, rs.Primary.Attributes["ip_address"], rs.Primary.Attributes["port"])
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("LB pool member %s still exists in LB pool %s", resourceID, poolID)
+		}
+	}
+	return nil
+}
+
+func testAccNSXLbPoolMemberCreateTemplate(name string, adminState string, weight int) string {
+	return fmt.Sprintf(`
+resource "nsxt_lb_pool" "shared" {
+  display_name            = "%s"
+  description             = "Acceptance Test"
+  algorithm               = "WEIGHTED_ROUND_ROBIN"
+  ignore_external_members = true
+
+  member {
+    ip_address = "1.1.1.1"
+    port       = "80"
+  }
+}
+
+resource "nsxt_lb_pool_member" "test" {
+  pool_id     = "${nsxt_lb_pool.shared.id}"
+  ip_address  = "1.1.1.2"
+  port        = "80"
+  admin_state = "%s"
+  weight      = %d
+}`, name, adminState, weight)
+}
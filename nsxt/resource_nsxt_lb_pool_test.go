@@ -10,6 +10,7 @@ import (
 	"github.com/vmware/go-vmware-nsxt"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestAccResourceNsxtLbPool_basic(t *testing.T) {
@@ -209,6 +210,53 @@ func TestAccResourceNsxtLbPool_withMember(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtLbPool_withMemberDrain(t *testing.T) {
+	name := "test-nsx-lb-pool-drain"
+	testResourceName := "nsxt_lb_pool.test"
+	var updateStart time.Time
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLbPoolCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLbPoolWithDrainTemplate(name, `
+  member {
+    ip_address = "1.1.1.1"
+    port       = "80"
+  }
+  member {
+    ip_address = "1.1.1.2"
+    port       = "80"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXLbPoolExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "drain_timeout", "5"),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() { updateStart = time.Now() },
+				Config: testAccNSXLbPoolWithDrainTemplate(name, `
+  member {
+    ip_address = "1.1.1.1"
+    port       = "80"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXLbPoolExists(name, testResourceName),
+					testAccNSXLbPoolDrained(&updateStart, 5),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.ip_address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.admin_state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtLbPool_withMemberGroup(t *testing.T) {
 	name := "test-nsx-lb-pool"
 	updatedName := fmt.Sprintf("%s-update", name)
@@ -316,6 +364,18 @@ func testAccNSXLbPoolExists(displayName string, resourceName string) resource.Te
 	}
 }
 
+// testAccNSXLbPoolDrained checks that removing members waited for the drain
+// timeout, as there is no NSX API to observe the drain itself
+func testAccNSXLbPoolDrained(start *time.Time, timeout int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		elapsed := time.Since(*start)
+		if elapsed < time.Duration(timeout)*time.Second {
+			return fmt.Errorf("LB Pool members were removed after %v, before the drain timeout of %d seconds", elapsed, timeout)
+		}
+		return nil
+	}
+}
+
 func testAccNSXLbPoolCheckDestroy(state *terraform.State, displayName string) error {
 	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
 	for _, rs := range state.RootModule().Resources {
@@ -549,6 +609,17 @@ resource "nsxt_lb_pool" "test" {
 `, name, algorithm, minActiveMembers, snatTranslationType, name, memberIP)
 }
 
+func testAccNSXLbPoolWithDrainTemplate(name string, members string) string {
+	return fmt.Sprintf(`
+resource "nsxt_lb_pool" "test" {
+  display_name  = "%s"
+  description   = "Acceptance Test"
+  drain_timeout = 5
+%s
+}
+`, name, members)
+}
+
 func testAccNSXLbPoolCreateWithMemberGroupTemplate(name string, algorithm string, size string, port string) string {
 	return fmt.Sprintf(`
 resource "nsxt_ns_group" "grp1" {
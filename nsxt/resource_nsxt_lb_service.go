@@ -25,6 +25,7 @@ func resourceNsxtLbService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceNsxtLbServiceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"revision": getRevisionSchema(),
@@ -80,6 +81,41 @@ func resourceNsxtLbService() *schema.Resource {
 	}
 }
 
+func resourceNsxtLbServiceCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("logical_router_id") {
+		return nil
+	}
+
+	// The router ID is empty if it is not known yet at plan time
+	logicalRouterID := d.Get("logical_router_id").(string)
+	if logicalRouterID == "" {
+		return nil
+	}
+
+	// The load balancer runs on the edge cluster of the tier1 router. Only the
+	// presence of edge nodes is checked, not whether they fit the service size
+	nsxClient := m.(*api.APIClient)
+	logicalRouter, _, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouter(nsxClient.Context, logicalRouterID)
+	if err != nil {
+		return fmt.Errorf("Error while reading logical router %s: %v", logicalRouterID, err)
+	}
+	if logicalRouter.RouterType != "TIER1" {
+		return fmt.Errorf("Logical router %s is not a tier1 router", logicalRouterID)
+	}
+	if logicalRouter.EdgeClusterId == "" {
+		return fmt.Errorf("Logical tier1 router %s has no edge cluster", logicalRouterID)
+	}
+	edgeCluster, _, err := nsxClient.NetworkTransportApi.ReadEdgeCluster(nsxClient.Context, logicalRouter.EdgeClusterId)
+	if err != nil {
+		return fmt.Errorf("Error while reading edge cluster %s: %v", logicalRouter.EdgeClusterId, err)
+	}
+	if len(edgeCluster.Members) == 0 {
+		return fmt.Errorf("Edge cluster %s of logical tier1 router %s has no edge nodes", logicalRouter.EdgeClusterId, logicalRouterID)
+	}
+
+	return nil
+}
+
 func resourceNsxtLbServiceCreate(d *schema.ResourceData, m interface{}) error {
 	nsxClient := m.(*api.APIClient)
 	description := d.Get("description").(string)
@@ -167,6 +203,7 @@ func resourceNsxtLbServiceUpdate(d *schema.ResourceData, m interface{}) error {
 	errorLogLevel := d.Get("error_log_level").(string)
 	size := d.Get("size").(string)
 	virtualServerIds := getStringListFromSchemaSet(d, "virtual_server_ids")
+
 	lbService := loadbalancer.LbService{
 		Revision:         revision,
 		Description:      description,
@@ -182,6 +219,10 @@ func resourceNsxtLbServiceUpdate(d *schema.ResourceData, m interface{}) error {
 	lbService, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerService(nsxClient.Context, id, lbService)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
+		if d.HasChange("size") {
+			oldSize, _ := d.GetChange("size")
+			return fmt.Errorf("Error during LbService resize from %s to %s: %v", oldSize, size, err)
+		}
 		return fmt.Errorf("Error during LbService update: %v", err)
 	}
 
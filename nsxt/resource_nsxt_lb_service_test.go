@@ -94,6 +94,36 @@ func TestAccResourceNsxtLbService_withServers(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtLbService_resize(t *testing.T) {
+	name := "test"
+	testResourceName := "nsxt_lb_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLbServiceCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLbServiceCreateTemplateWithSize("SMALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXLbServiceExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "size", "SMALL"),
+				),
+			},
+			{
+				Config: testAccNSXLbServiceCreateTemplateWithSize("MEDIUM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXLbServiceExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "size", "MEDIUM"),
+					resource.TestCheckResourceAttrPair(testResourceName, "logical_router_id", "nsxt_logical_tier1_router.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtLbService_importBasic(t *testing.T) {
 	name := "test"
 	testResourceName := "nsxt_lb_service.test"
@@ -219,6 +249,17 @@ resource "nsxt_lb_service" "test" {
 }`
 }
 
+func testAccNSXLbServiceCreateTemplateWithSize(size string) string {
+	return testAccNSXLbCreateTopology() + fmt.Sprintf(`
+resource "nsxt_lb_service" "test" {
+  display_name      = "test"
+  logical_router_id = "${nsxt_logical_tier1_router.test.id}"
+  size              = "%s"
+
+  depends_on = ["nsxt_logical_router_link_port_on_tier1.test"]
+}`, size)
+}
+
 func testAccNSXLbServiceUpdateTemplate() string {
 	return testAccNSXLbCreateTopology() + `
 resource "nsxt_lb_service" "test" {
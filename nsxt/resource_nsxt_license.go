@@ -0,0 +1,164 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/licensing"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+func resourceNsxtLicense() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtLicenseCreate,
+		Read:   resourceNsxtLicenseRead,
+		Delete: resourceNsxtLicenseDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtLicenseImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"license_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "License key",
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "License edition",
+				Computed:    true,
+			},
+			"product_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Product name",
+				Computed:    true,
+			},
+			"product_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Product version",
+				Computed:    true,
+			},
+			"features": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Features included in the license",
+				Computed:    true,
+			},
+			"capacity_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "License metric",
+				Computed:    true,
+			},
+			"quantity": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Number of units of the capacity type the license allows",
+				Computed:    true,
+			},
+			"expiry": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Expiry date of the license in epoch milliseconds, 0 if it never expires",
+				Computed:    true,
+			},
+			"is_eval": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether this is an evaluation license",
+				Computed:    true,
+			},
+			"is_expired": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the license has expired",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// The license key is sensitive, so the resource ID is derived from it rather
+// than being the key itself
+func getLicenseID(licenseKey string) string {
+	return strconv.Itoa(hashcode.String(licenseKey))
+}
+
+func resourceNsxtLicenseCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	licenseKey := d.Get("license_key").(string)
+	license := licensing.License{
+		LicenseKey: licenseKey,
+	}
+
+	_, resp, err := nsxClient.LicensingApi.CreateLicense(nsxClient.Context, license)
+	if err != nil {
+		return fmt.Errorf("Error during License create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during License create: %v", resp.StatusCode)
+	}
+	d.SetId(getLicenseID(licenseKey))
+
+	return resourceNsxtLicenseRead(d, m)
+}
+
+func resourceNsxtLicenseRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	license, resp, err := nsxClient.LicensingApi.GetLicenseByKey(nsxClient.Context, d.Get("license_key").(string))
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] License %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during License read: %v", err)
+	}
+
+	d.Set("description", license.Description)
+	d.Set("product_name", license.ProductName)
+	d.Set("product_version", license.ProductVersion)
+	d.Set("features", license.Features)
+	d.Set("capacity_type", license.CapacityType)
+	d.Set("quantity", license.Quantity)
+	d.Set("expiry", license.Expiry)
+	d.Set("is_eval", license.IsEval)
+	d.Set("is_expired", license.IsExpired)
+
+	return nil
+}
+
+func resourceNsxtLicenseDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.LicensingApi.DeleteLicense(nsxClient.Context, d.Get("license_key").(string))
+	if err != nil {
+		return fmt.Errorf("Error during License delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] License %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
+
+// The import ID is the license key
+func resourceNsxtLicenseImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	licenseKey := d.Id()
+	d.Set("license_key", licenseKey)
+	d.SetId(getLicenseID(licenseKey))
+	return []*schema.ResourceData{d}, nil
+}
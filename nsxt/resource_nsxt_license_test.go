@@ -0,0 +1,94 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"os"
+	"testing"
+)
+
+func TestAccResourceNsxtLicense_basic(t *testing.T) {
+	testResourceName := "nsxt_license.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_LICENSE_KEY")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLicenseCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLicenseTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "description"),
+					resource.TestCheckResourceAttrSet(testResourceName, "product_name"),
+					resource.TestCheckResourceAttrSet(testResourceName, "capacity_type"),
+					resource.TestCheckResourceAttr(testResourceName, "is_expired", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtLicense_importBasic(t *testing.T) {
+	testResourceName := "nsxt_license.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_LICENSE_KEY")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLicenseCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLicenseTemplate(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateId:     os.Getenv("NSXT_TEST_LICENSE_KEY"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXLicenseCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_license" {
+			continue
+		}
+
+		_, responseCode, err := nsxClient.LicensingApi.GetLicenseByKey(nsxClient.Context, rs.Primary.Attributes["license_key"])
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving License. Error: %v", err)
+		}
+
+		return fmt.Errorf("License %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccNSXLicenseTemplate() string {
+	return fmt.Sprintf(`
+resource "nsxt_license" "test" {
+  license_key = "%s"
+}`, os.Getenv("NSXT_TEST_LICENSE_KEY"))
+}
@@ -43,7 +43,12 @@ func resourceNsxtLogicalPort() *schema.Resource {
 			},
 			"admin_state":          getAdminStateSchema(),
 			"switching_profile_id": getSwitchingProfileIdsSchema(),
-			"tag": getTagsSchema(),
+			"tag":                  getTagsSchema(),
+			"metadata_proxy_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Id of the Metadata proxy attached to this port",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -65,6 +70,13 @@ func resourceNsxtLogicalPortCreate(d *schema.ResourceData, m interface{}) error
 		SwitchingProfileIds: profilesList,
 		Tags:                tagList}
 
+	if metadataProxyID := d.Get("metadata_proxy_id").(string); metadataProxyID != "" {
+		lp.Attachment = &manager.LogicalPortAttachment{
+			AttachmentType: metadataProxyType,
+			Id:             metadataProxyID,
+		}
+	}
+
 	lp, resp, err := nsxClient.LogicalSwitchingApi.CreateLogicalPort(nsxClient.Context, lp)
 
 	if err != nil {
@@ -107,6 +119,12 @@ func resourceNsxtLogicalPortRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during logical port switching profiles set in schema: %v", err)
 	}
 	setTagsInSchema(d, logicalPort.Tags)
+	// Other types of attachments are managed outside of this resource
+	if logicalPort.Attachment != nil && logicalPort.Attachment.AttachmentType == metadataProxyType {
+		d.Set("metadata_proxy_id", logicalPort.Attachment.Id)
+	} else {
+		d.Set("metadata_proxy_id", "")
+	}
 
 	return nil
 }
@@ -121,11 +139,12 @@ func resourceNsxtLogicalPortUpdate(d *schema.ResourceData, m interface{}) error
 	tagList := getTagsFromSchema(d)
 	revision := int64(d.Get("revision").(int))
 
-	// Some of the port attributes (attachment) are not exposed to terraform.
+	// Some of the port attributes are not exposed to terraform.
 	// If we try to update port based on terraform attributes only, apply will fail
 	// due to missing info.
-	// We don't expose attachment to terraform, since it will become out of sync
-	// once attachment info is updated/remove outside the scope of port management.
+	// Only metadata proxy attachments are exposed to terraform. Other attachments,
+	// such as VIFs, are managed outside the scope of port management, and are kept
+	// as they are.
 
 	lp, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalPort(nsxClient.Context, id)
 	if resp.StatusCode == http.StatusNotFound {
@@ -141,6 +160,20 @@ func resourceNsxtLogicalPortUpdate(d *schema.ResourceData, m interface{}) error
 	lp.SwitchingProfileIds = profilesList
 	lp.Tags = tagList
 	lp.Revision = revision
+	if d.HasChange("metadata_proxy_id") {
+		metadataProxyID := d.Get("metadata_proxy_id").(string)
+		if metadataProxyID != "" {
+			if lp.Attachment != nil && lp.Attachment.AttachmentType != metadataProxyType {
+				return fmt.Errorf("Logical port %s already has a %s attachment %s, and cannot be attached to metadata proxy %s", id, lp.Attachment.AttachmentType, lp.Attachment.Id, metadataProxyID)
+			}
+			lp.Attachment = &manager.LogicalPortAttachment{
+				AttachmentType: metadataProxyType,
+				Id:             metadataProxyID,
+			}
+		} else if lp.Attachment != nil && lp.Attachment.AttachmentType == metadataProxyType {
+			lp.Attachment = nil
+		}
+	}
 
 	lp, resp, err = nsxClient.LogicalSwitchingApi.UpdateLogicalPort(nsxClient.Context, id, lp)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
@@ -0,0 +1,152 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"log"
+	"net/http"
+)
+
+func resourceNsxtMacSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtMacSetCreate,
+		Read:   resourceNsxtMacSetRead,
+		Update: resourceNsxtMacSetUpdate,
+		Delete: resourceNsxtMacSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"mac_addresses": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "Set of MAC addresses",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateMacAddress(),
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceNsxtMacSetCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	macAddresses := getStringListFromSchemaSet(d, "mac_addresses")
+	macSet := manager.MacSet{
+		Description:  description,
+		DisplayName:  displayName,
+		Tags:         tags,
+		MacAddresses: macAddresses,
+	}
+
+	macSet, resp, err := nsxClient.GroupingObjectsApi.CreateMACSet(nsxClient.Context, macSet)
+
+	if err != nil {
+		return fmt.Errorf("Error during MacSet create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during MacSet create: %v", resp.StatusCode)
+	}
+	d.SetId(macSet.Id)
+
+	return resourceNsxtMacSetRead(d, m)
+}
+
+func resourceNsxtMacSetRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	macSet, resp, err := nsxClient.GroupingObjectsApi.ReadMACSet(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during MacSet read: %v", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] MacSet %s not found", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("revision", macSet.Revision)
+	d.Set("description", macSet.Description)
+	d.Set("display_name", macSet.DisplayName)
+	setTagsInSchema(d, macSet.Tags)
+	d.Set("mac_addresses", macSet.MacAddresses)
+
+	return nil
+}
+
+func resourceNsxtMacSetUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	revision := int64(d.Get("revision").(int))
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	macAddresses := interface2StringList(d.Get("mac_addresses").(*schema.Set).List())
+	macSet := manager.MacSet{
+		Revision:     revision,
+		Description:  description,
+		DisplayName:  displayName,
+		Tags:         tags,
+		MacAddresses: macAddresses,
+	}
+
+	macSet, resp, err := nsxClient.GroupingObjectsApi.UpdateMACSet(nsxClient.Context, id, macSet)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during MacSet update: %v", err)
+	}
+
+	return resourceNsxtMacSetRead(d, m)
+}
+
+func resourceNsxtMacSetDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	resp, err := nsxClient.GroupingObjectsApi.DeleteMACSet(nsxClient.Context, id, localVarOptionals)
+	if err != nil {
+		return fmt.Errorf("Error during MacSet delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] MacSet %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+func TestAccResourceNsxtMacSet_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-mac-set")
+	updateName := fmt.Sprintf("%s-update", name)
+	testResourceName := "nsxt_mac_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXMacSetCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXMacSetCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXMacSetExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_addresses.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXMacSetUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXMacSetExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_addresses.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtMacSet_noName(t *testing.T) {
+	name := ""
+	testResourceName := "nsxt_mac_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXMacSetCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXMacSetCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXMacSetExists(name, testResourceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "display_name"),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_addresses.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXMacSetUpdateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXMacSetExists(name, testResourceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "display_name"),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_addresses.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtMacSet_importBasic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-mac-set")
+	testResourceName := "nsxt_mac_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXMacSetCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXMacSetCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXMacSetExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("MAC Set resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("MAC Set resource ID not set in resources ")
+		}
+
+		profile, responseCode, err := nsxClient.GroupingObjectsApi.ReadMACSet(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving MAC Set ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if MAC Set %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		// Ignore display name to support the 'no-name' test
+		if displayName == "" || displayName == profile.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("MAC Set %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXMacSetCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_mac_set" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		profile, responseCode, err := nsxClient.GroupingObjectsApi.ReadMACSet(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving MAC Set ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == profile.DisplayName {
+			return fmt.Errorf("MAC Set %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXMacSetCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_mac_set" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  mac_addresses = ["00:11:22:33:44:55"]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXMacSetUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_mac_set" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test Update"
+  mac_addresses = ["00:11:22:33:44:55", "00:11:22:33:44:66", "00-11-22-33-44-77"]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName)
+}
@@ -0,0 +1,127 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/administration"
+	"log"
+)
+
+const managerDNSConfigID = "dns"
+
+func resourceNsxtManagerDNSConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtManagerDNSConfigCreate,
+		Read:   resourceNsxtManagerDNSConfigRead,
+		Update: resourceNsxtManagerDNSConfigUpdate,
+		Delete: resourceNsxtManagerDNSConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name_servers": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Name servers",
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateSingleIP(),
+				},
+			},
+			"search_domains": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Search domains",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNsxtManagerDNSConfigApply(nsxClient *api.APIClient, nameServers []string, searchDomains []string) error {
+	_, _, err := nsxClient.NsxComponentAdministrationApi.UpdateNodeNameServers(nsxClient.Context, administration.NodeNameServersProperties{
+		NameServers: nameServers,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, _, err = nsxClient.NsxComponentAdministrationApi.UpdateNodeSearchDomains(nsxClient.Context, administration.NodeSearchDomainsProperties{
+		SearchDomains: searchDomains,
+	})
+	return err
+}
+
+func resourceNsxtManagerDNSConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+
+	err := resourceNsxtManagerDNSConfigApply(nsxClient, interface2StringList(d.Get("name_servers").([]interface{})), interface2StringList(d.Get("search_domains").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("Error during NodeDNSConfig create: %v", err)
+	}
+
+	d.SetId(managerDNSConfigID)
+
+	return resourceNsxtManagerDNSConfigRead(d, m)
+}
+
+func resourceNsxtManagerDNSConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	nameServers, _, err := nsxClient.NsxComponentAdministrationApi.ReadNodeNameServers(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during NodeNameServers read: %v", err)
+	}
+
+	searchDomains, _, err := nsxClient.NsxComponentAdministrationApi.ReadNodeSearchDomains(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during NodeSearchDomains read: %v", err)
+	}
+
+	d.Set("name_servers", nameServers.NameServers)
+	d.Set("search_domains", searchDomains.SearchDomains)
+
+	return nil
+}
+
+func resourceNsxtManagerDNSConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	err := resourceNsxtManagerDNSConfigApply(nsxClient, interface2StringList(d.Get("name_servers").([]interface{})), interface2StringList(d.Get("search_domains").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("Error during NodeDNSConfig update: %v", err)
+	}
+
+	return resourceNsxtManagerDNSConfigRead(d, m)
+}
+
+func resourceNsxtManagerDNSConfigDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	err := resourceNsxtManagerDNSConfigApply(nsxClient, []string{}, []string{})
+	if err != nil {
+		return fmt.Errorf("Error during NodeDNSConfig delete: %v", err)
+	}
+
+	log.Printf("[DEBUG] NodeDNSConfig %s removed", id)
+	return nil
+}
@@ -0,0 +1,68 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"testing"
+)
+
+// Destroying this resource removes the name servers of the manager node, so
+// the test only runs when explicitly enabled
+func TestAccResourceNsxtManagerDNSConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_manager_dns_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_MANAGER_NODE_CONFIG")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXManagerDNSConfigCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXManagerDNSConfigTemplate(`["8.8.8.8"]`, `["example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "name_servers.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "name_servers.0", "8.8.8.8"),
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXManagerDNSConfigTemplate(`["8.8.8.8", "8.8.4.4"]`, `["example.com", "example.org"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "name_servers.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.1", "example.org"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXManagerDNSConfigCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	nameServers, _, err := nsxClient.NsxComponentAdministrationApi.ReadNodeNameServers(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while retrieving name servers. Error: %v", err)
+	}
+
+	if len(nameServers.NameServers) > 0 {
+		return fmt.Errorf("Name servers were not removed")
+	}
+	return nil
+}
+
+func testAccNSXManagerDNSConfigTemplate(nameServers string, searchDomains string) string {
+	return fmt.Sprintf(`
+resource "nsxt_manager_dns_config" "test" {
+  name_servers   = %s
+  search_domains = %s
+}`, nameServers, searchDomains)
+}
@@ -0,0 +1,147 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/administration"
+	"log"
+	"net/http"
+)
+
+const managerNTPConfigID = "ntp"
+
+func resourceNsxtManagerNtpConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtManagerNtpConfigCreate,
+		Read:   resourceNsxtManagerNtpConfigRead,
+		Update: resourceNsxtManagerNtpConfigUpdate,
+		Delete: resourceNsxtManagerNtpConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"servers": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "NTP servers",
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"runtime_state": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Runtime state of the NTP service",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func restartManagerNtpService(nsxClient *api.APIClient) error {
+	return restartManagerNodeService("NTP",
+		func() (administration.NodeServiceStatusProperties, *http.Response, error) {
+			return nsxClient.NsxComponentAdministrationApi.CreateNTPServiceActionRestart(nsxClient.Context)
+		},
+		func() (administration.NodeServiceStatusProperties, *http.Response, error) {
+			return nsxClient.NsxComponentAdministrationApi.ReadNTPServiceStatus(nsxClient.Context)
+		})
+}
+
+func resourceNsxtManagerNtpConfigApply(nsxClient *api.APIClient, servers []string) (administration.NodeNtpServiceProperties, error) {
+	// Get the current configuration in order to keep its service name
+	service, _, err := nsxClient.NsxComponentAdministrationApi.ReadNTPService(nsxClient.Context)
+	if err != nil {
+		return service, err
+	}
+
+	service.ServiceProperties = &administration.NtpServiceProperties{
+		Servers: servers,
+	}
+	service, _, err = nsxClient.NsxComponentAdministrationApi.UpdateNTPService(nsxClient.Context, service)
+	return service, err
+}
+
+func resourceNsxtManagerNtpConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+
+	_, err := resourceNsxtManagerNtpConfigApply(nsxClient, interface2StringList(d.Get("servers").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("Error during NodeNtpService create: %v", err)
+	}
+
+	d.SetId(managerNTPConfigID)
+
+	err = restartManagerNtpService(nsxClient)
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtManagerNtpConfigRead(d, m)
+}
+
+func resourceNsxtManagerNtpConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	service, _, err := nsxClient.NsxComponentAdministrationApi.ReadNTPService(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during NodeNtpService read: %v", err)
+	}
+
+	status, _, err := nsxClient.NsxComponentAdministrationApi.ReadNTPServiceStatus(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during NodeNtpService status read: %v", err)
+	}
+
+	if service.ServiceProperties != nil {
+		d.Set("servers", service.ServiceProperties.Servers)
+	} else {
+		d.Set("servers", nil)
+	}
+	d.Set("runtime_state", status.RuntimeState)
+
+	return nil
+}
+
+func resourceNsxtManagerNtpConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	_, err := resourceNsxtManagerNtpConfigApply(nsxClient, interface2StringList(d.Get("servers").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("Error during NodeNtpService update: %v", err)
+	}
+
+	err = restartManagerNtpService(nsxClient)
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtManagerNtpConfigRead(d, m)
+}
+
+func resourceNsxtManagerNtpConfigDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	_, err := resourceNsxtManagerNtpConfigApply(nsxClient, []string{})
+	if err != nil {
+		return fmt.Errorf("Error during NodeNtpService delete: %v", err)
+	}
+
+	log.Printf("[DEBUG] NodeNtpService %s servers removed", id)
+	return nil
+}
@@ -0,0 +1,68 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"testing"
+)
+
+// Destroying this resource removes the NTP servers of the manager node, so
+// the test only runs when explicitly enabled
+func TestAccResourceNsxtManagerNtpConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_manager_ntp_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_MANAGER_NODE_CONFIG")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXManagerNtpConfigCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXManagerNtpConfigTemplate(`["0.pool.ntp.org"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "id", "ntp"),
+					resource.TestCheckResourceAttr(testResourceName, "servers.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "servers.0", "0.pool.ntp.org"),
+					resource.TestCheckResourceAttr(testResourceName, "runtime_state", "running"),
+				),
+			},
+			{
+				Config: testAccNSXManagerNtpConfigTemplate(`["0.pool.ntp.org", "1.pool.ntp.org"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "servers.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "servers.1", "1.pool.ntp.org"),
+					resource.TestCheckResourceAttr(testResourceName, "runtime_state", "running"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXManagerNtpConfigCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	service, _, err := nsxClient.NsxComponentAdministrationApi.ReadNTPService(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while retrieving NTP service. Error: %v", err)
+	}
+
+	if service.ServiceProperties != nil && len(service.ServiceProperties.Servers) > 0 {
+		return fmt.Errorf("NTP servers were not removed")
+	}
+	return nil
+}
+
+func testAccNSXManagerNtpConfigTemplate(servers string) string {
+	return fmt.Sprintf(`
+resource "nsxt_manager_ntp_config" "test" {
+  servers = %s
+}`, servers)
+}
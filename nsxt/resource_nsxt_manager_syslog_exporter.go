@@ -0,0 +1,220 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/administration"
+	"log"
+	"net/http"
+	"time"
+)
+
+var syslogExporterProtocolValues = []string{"TCP", "TLS", "UDP", "LI", "LI-TLS"}
+var syslogExporterLevelValues = []string{"EMERG", "ALERT", "CRIT", "ERR", "WARNING", "NOTICE", "INFO", "DEBUG"}
+
+const managerNodeServiceRestartTimeout = 2 * time.Minute
+
+type managerNodeServiceAction func() (administration.NodeServiceStatusProperties, *http.Response, error)
+
+// Manager node services are restarted after a configuration change, so that
+// the change is applied right away
+func restartManagerNodeService(serviceName string, restart managerNodeServiceAction, readStatus managerNodeServiceAction) error {
+	_, _, err := restart()
+	if err != nil {
+		return fmt.Errorf("Error while restarting %s service: %v", serviceName, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"stopped", "stopping", "starting", "restarting"},
+		Target:  []string{"running"},
+		Refresh: func() (interface{}, string, error) {
+			status, _, err := readStatus()
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying %s service status: %v", serviceName, err)
+			}
+
+			log.Printf("[DEBUG] %s service runtime state: %s", serviceName, status.RuntimeState)
+			return status, status.RuntimeState, nil
+		},
+		Timeout:    managerNodeServiceRestartTimeout,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func restartManagerSyslogService(nsxClient *api.APIClient) error {
+	return restartManagerNodeService("syslog",
+		func() (administration.NodeServiceStatusProperties, *http.Response, error) {
+			return nsxClient.NsxComponentAdministrationApi.CreateSyslogServiceActionRestart(nsxClient.Context)
+		},
+		func() (administration.NodeServiceStatusProperties, *http.Response, error) {
+			return nsxClient.NsxComponentAdministrationApi.ReadSyslogServiceStatus(nsxClient.Context)
+		})
+}
+
+func resourceNsxtManagerSyslogExporter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtManagerSyslogExporterCreate,
+		Read:   resourceNsxtManagerSyslogExporterRead,
+		Delete: resourceNsxtManagerSyslogExporterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"exporter_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Name of the syslog exporter",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"server": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "IP address or hostname of the server to export to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Port to export to",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      514,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Export protocol",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(syslogExporterProtocolValues, false),
+			},
+			"level": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Minimal logging level to export",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(syslogExporterLevelValues, false),
+			},
+			"facilities": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "Facilities to export",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"msgids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "Message IDs to export",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"structured_data": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "Structured data to export",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tls_ca_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "CA certificate PEM of the TLS server to export to",
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtManagerSyslogExporterCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	exporter := administration.NodeSyslogExporterProperties{
+		ExporterName:   d.Get("exporter_name").(string),
+		Server:         d.Get("server").(string),
+		Port:           int64(d.Get("port").(int)),
+		Protocol:       d.Get("protocol").(string),
+		Level:          d.Get("level").(string),
+		Facilities:     getStringListFromSchemaSet(d, "facilities"),
+		Msgids:         getStringListFromSchemaSet(d, "msgids"),
+		StructuredData: getStringListFromSchemaSet(d, "structured_data"),
+		TlsCaPem:       d.Get("tls_ca_pem").(string),
+	}
+
+	exporter, resp, err := nsxClient.NsxComponentAdministrationApi.PostNodeSyslogExporter(nsxClient.Context, exporter)
+
+	if err != nil {
+		return fmt.Errorf("Error during NodeSyslogExporter create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status returned during NodeSyslogExporter create: %v", resp.StatusCode)
+	}
+	d.SetId(exporter.ExporterName)
+
+	err = restartManagerSyslogService(nsxClient)
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtManagerSyslogExporterRead(d, m)
+}
+
+func resourceNsxtManagerSyslogExporterRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	exporter, resp, err := nsxClient.NsxComponentAdministrationApi.ReadNodeSyslogExporter(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] NodeSyslogExporter %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during NodeSyslogExporter read: %v", err)
+	}
+
+	d.Set("exporter_name", exporter.ExporterName)
+	d.Set("server", exporter.Server)
+	d.Set("port", exporter.Port)
+	d.Set("protocol", exporter.Protocol)
+	d.Set("level", exporter.Level)
+	d.Set("facilities", exporter.Facilities)
+	d.Set("msgids", exporter.Msgids)
+	d.Set("structured_data", exporter.StructuredData)
+	d.Set("tls_ca_pem", exporter.TlsCaPem)
+
+	return nil
+}
+
+func resourceNsxtManagerSyslogExporterDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.NsxComponentAdministrationApi.DeleteNodeSyslogExporter(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during NodeSyslogExporter delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] NodeSyslogExporter %s not found", id)
+		d.SetId("")
+		return nil
+	}
+
+	return restartManagerSyslogService(nsxClient)
+}
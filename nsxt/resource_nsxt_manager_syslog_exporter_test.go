@@ -0,0 +1,137 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+func TestAccResourceNsxtManagerSyslogExporter_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-syslog-exporter")
+	testResourceName := "nsxt_manager_syslog_exporter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXManagerSyslogExporterCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXManagerSyslogExporterCreateTemplate(name, "1.1.1.1", "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXManagerSyslogExporterExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "exporter_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "server", "1.1.1.1"),
+					resource.TestCheckResourceAttr(testResourceName, "port", "514"),
+					resource.TestCheckResourceAttr(testResourceName, "protocol", "UDP"),
+					resource.TestCheckResourceAttr(testResourceName, "level", "INFO"),
+					resource.TestCheckResourceAttr(testResourceName, "facilities.#", "2"),
+				),
+			},
+			{
+				Config: testAccNSXManagerSyslogExporterCreateTemplate(name, "2.2.2.2", "WARNING"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXManagerSyslogExporterExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "server", "2.2.2.2"),
+					resource.TestCheckResourceAttr(testResourceName, "level", "WARNING"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtManagerSyslogExporter_importBasic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-syslog-exporter")
+	testResourceName := "nsxt_manager_syslog_exporter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXManagerSyslogExporterCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXManagerSyslogExporterCreateTemplate(name, "1.1.1.1", "INFO"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXManagerSyslogExporterExists(exporterName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Syslog exporter resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Syslog exporter resource ID not set in resources ")
+		}
+
+		exporter, responseCode, err := nsxClient.NsxComponentAdministrationApi.ReadNodeSyslogExporter(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving syslog exporter %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if syslog exporter %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if exporterName == exporter.ExporterName {
+			return nil
+		}
+		return fmt.Errorf("Syslog exporter %s wasn't found", exporterName)
+	}
+}
+
+func testAccNSXManagerSyslogExporterCheckDestroy(state *terraform.State, exporterName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_manager_syslog_exporter" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exporter, responseCode, err := nsxClient.NsxComponentAdministrationApi.ReadNodeSyslogExporter(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving syslog exporter %s. Error: %v", resourceID, err)
+		}
+
+		if exporterName == exporter.ExporterName {
+			return fmt.Errorf("Syslog exporter %s still exists", exporterName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXManagerSyslogExporterCreateTemplate(name string, server string, level string) string {
+	return fmt.Sprintf(`
+resource "nsxt_manager_syslog_exporter" "test" {
+  exporter_name = "%s"
+  server        = "%s"
+  protocol      = "UDP"
+  level         = "%s"
+  facilities    = ["KERN", "USER"]
+}`, name, server, level)
+}
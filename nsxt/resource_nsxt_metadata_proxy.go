@@ -0,0 +1,175 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"log"
+	"net/http"
+)
+
+// Attachment type of logical ports attached to a metadata proxy
+const metadataProxyType = "METADATA_PROXY"
+
+func resourceNsxtMetadataProxy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtMetadataProxyCreate,
+		Read:   resourceNsxtMetadataProxyRead,
+		Update: resourceNsxtMetadataProxyUpdate,
+		Delete: resourceNsxtMetadataProxyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"edge_cluster_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Edge cluster on which the metadata proxy runs",
+				Required:    true,
+			},
+			"edge_cluster_member_indexes": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Indexes of the edge cluster members running the metadata proxy. If not set, NSX selects two members of the edge cluster",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"metadata_server_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "URL of the metadata server, in scheme://host:port/path format",
+				Required:    true,
+			},
+			"metadata_server_ca_ids": getIDSetSchema("CA certificates used to verify the metadata server"),
+			"secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Secret to access the metadata server",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"attached_logical_port_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the logical port the metadata proxy is attached to",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getMetadataProxyFromSchema(d *schema.ResourceData) manager.MetadataProxy {
+	return manager.MetadataProxy{
+		Description:              d.Get("description").(string),
+		DisplayName:              d.Get("display_name").(string),
+		Tags:                     getTagsFromSchema(d),
+		EdgeClusterId:            d.Get("edge_cluster_id").(string),
+		EdgeClusterMemberIndexes: intList2int64List(d.Get("edge_cluster_member_indexes").([]interface{})),
+		MetadataServerUrl:        d.Get("metadata_server_url").(string),
+		MetadataServerCaIds:      getStringListFromSchemaSet(d, "metadata_server_ca_ids"),
+		Secret:                   d.Get("secret").(string),
+	}
+}
+
+func resourceNsxtMetadataProxyCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	proxy := getMetadataProxyFromSchema(d)
+
+	proxy, resp, err := nsxClient.ServicesApi.CreateMetadataProxy(nsxClient.Context, proxy)
+
+	if err != nil {
+		return fmt.Errorf("Error during MetadataProxy create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during MetadataProxy create: %v", resp.StatusCode)
+	}
+	d.SetId(proxy.Id)
+
+	return resourceNsxtMetadataProxyRead(d, m)
+}
+
+func resourceNsxtMetadataProxyRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	proxy, resp, err := nsxClient.ServicesApi.ReadMetadataProxy(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] MetadataProxy %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during MetadataProxy read: %v", err)
+	}
+
+	d.Set("revision", proxy.Revision)
+	d.Set("description", proxy.Description)
+	d.Set("display_name", proxy.DisplayName)
+	setTagsInSchema(d, proxy.Tags)
+	d.Set("edge_cluster_id", proxy.EdgeClusterId)
+	d.Set("edge_cluster_member_indexes", proxy.EdgeClusterMemberIndexes)
+	d.Set("metadata_server_url", proxy.MetadataServerUrl)
+	d.Set("metadata_server_ca_ids", proxy.MetadataServerCaIds)
+	// The secret is not returned by NSX, so the configured value is kept
+	d.Set("attached_logical_port_id", proxy.AttachedLogicalPortId)
+
+	return nil
+}
+
+func resourceNsxtMetadataProxyUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	proxy := getMetadataProxyFromSchema(d)
+	proxy.Revision = int64(d.Get("revision").(int))
+
+	_, resp, err := nsxClient.ServicesApi.UpdateMetadataProxy(nsxClient.Context, id, proxy)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during MetadataProxy update: %v", err)
+	}
+
+	return resourceNsxtMetadataProxyRead(d, m)
+}
+
+func resourceNsxtMetadataProxyDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.ServicesApi.DeleteMetadataProxy(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during MetadataProxy delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] MetadataProxy %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+func TestAccResourceNsxtMetadataProxy_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-metadata-proxy")
+	updateName := fmt.Sprintf("%s-update", name)
+	testResourceName := "nsxt_metadata_proxy.test"
+	edgeClusterName := getEdgeClusterName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXMetadataProxyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXMetadataProxyCreateTemplate(edgeClusterName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXMetadataProxyExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_id"),
+					resource.TestCheckResourceAttr(testResourceName, "metadata_server_url", "http://1.1.1.1:3000/"),
+					resource.TestCheckResourceAttr(testResourceName, "secret", "secret1"),
+				),
+			},
+			{
+				Config: testAccNSXMetadataProxyUpdateTemplate(edgeClusterName, updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXMetadataProxyExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "metadata_server_url", "http://1.1.1.2:3001/"),
+					resource.TestCheckResourceAttr(testResourceName, "secret", "secret2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtMetadataProxy_withPort(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-metadata-proxy")
+	testResourceName := "nsxt_metadata_proxy.test"
+	portResourceName := "nsxt_logical_port.test"
+	edgeClusterName := getEdgeClusterName()
+	transportZoneName := getOverlayTransportZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXMetadataProxyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXMetadataProxyWithPortTemplate(edgeClusterName, transportZoneName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXMetadataProxyExists(name, testResourceName),
+					resource.TestCheckResourceAttrPair(portResourceName, "metadata_proxy_id", testResourceName, "id"),
+				),
+			},
+			{
+				// Detach the metadata proxy from the port
+				Config: testAccNSXMetadataProxyWithPortTemplate(edgeClusterName, transportZoneName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXMetadataProxyExists(name, testResourceName),
+					resource.TestCheckResourceAttr(portResourceName, "metadata_proxy_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtMetadataProxy_importBasic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-metadata-proxy")
+	testResourceName := "nsxt_metadata_proxy.test"
+	edgeClusterName := getEdgeClusterName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXMetadataProxyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXMetadataProxyCreateTemplate(edgeClusterName, name),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func testAccNSXMetadataProxyExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Metadata proxy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Metadata proxy resource ID not set in resources ")
+		}
+
+		proxy, responseCode, err := nsxClient.ServicesApi.ReadMetadataProxy(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving metadata proxy ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if metadata proxy %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == proxy.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Metadata proxy %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXMetadataProxyCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_metadata_proxy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		proxy, responseCode, err := nsxClient.ServicesApi.ReadMetadataProxy(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving metadata proxy ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == proxy.DisplayName {
+			return fmt.Errorf("Metadata proxy %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXMetadataProxyCreateTemplate(edgeClusterName string, name string) string {
+	return fmt.Sprintf(`
+data "nsxt_edge_cluster" "EC" {
+  display_name = "%s"
+}
+
+resource "nsxt_metadata_proxy" "test" {
+  display_name        = "%s"
+  description         = "Acceptance Test"
+  edge_cluster_id     = "${data.nsxt_edge_cluster.EC.id}"
+  metadata_server_url = "http://1.1.1.1:3000/"
+  secret              = "secret1"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, edgeClusterName, name)
+}
+
+func testAccNSXMetadataProxyUpdateTemplate(edgeClusterName string, updatedName string) string {
+	return fmt.Sprintf(`
+data "nsxt_edge_cluster" "EC" {
+  display_name = "%s"
+}
+
+resource "nsxt_metadata_proxy" "test" {
+  display_name        = "%s"
+  description         = "Acceptance Test Update"
+  edge_cluster_id     = "${data.nsxt_edge_cluster.EC.id}"
+  metadata_server_url = "http://1.1.1.2:3001/"
+  secret              = "secret2"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, edgeClusterName, updatedName)
+}
+
+func testAccNSXMetadataProxyWithPortTemplate(edgeClusterName string, transportZoneName string, name string, attached bool) string {
+	metadataProxyID := ""
+	if attached {
+		metadataProxyID = "${nsxt_metadata_proxy.test.id}"
+	}
+	return testAccNSXMetadataProxyCreateTemplate(edgeClusterName, name) + testAccNSXLogicalSwitchCreateForPort(transportZoneName) + fmt.Sprintf(`
+
+resource "nsxt_logical_port" "test" {
+  display_name      = "%s"
+  logical_switch_id = "${nsxt_logical_switch.test.id}"
+  metadata_proxy_id = "%s"
+}`, name, metadataProxyID)
+}
@@ -58,6 +58,12 @@ func resourceNsxtNsGroup() *schema.Resource {
 					},
 				},
 			},
+			"ignore_external_members": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Ignore members which are not configured in this resource, such as the ones managed by nsxt_ns_group_member",
+				Optional:    true,
+				Default:     false,
+			},
 			"membership_criteria": &schema.Schema{
 				Type:        schema.TypeList,
 				Description: "List of tag expressions which define the membership criteria for this NSGroup.",
@@ -146,6 +152,53 @@ func getMembersFromSchema(d *schema.ResourceData) []manager.NsGroupSimpleExpress
 	return expresionList
 }
 
+func getNsGroupMemberKey(targetType string, value string) string {
+	return fmt.Sprintf("%s/%s", targetType, value)
+}
+
+func getNsGroupMemberKeys(members []interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	for _, member := range members {
+		data := member.(map[string]interface{})
+		keys[getNsGroupMemberKey(data["target_type"].(string), data["value"].(string))] = true
+	}
+	return keys
+}
+
+// filterNsGroupMembers returns only the members configured in this resource
+func filterNsGroupMembers(d *schema.ResourceData, members []manager.NsGroupSimpleExpression) []manager.NsGroupSimpleExpression {
+	keys := getNsGroupMemberKeys(d.Get("member").(*schema.Set).List())
+	var filtered []manager.NsGroupSimpleExpression
+	for _, member := range members {
+		if keys[getNsGroupMemberKey(member.TargetType, member.Value)] {
+			filtered = append(filtered, member)
+		}
+	}
+	return filtered
+}
+
+// getExternalNsGroupMembers returns the current members of the group which
+// were not configured in this resource before the update
+func getExternalNsGroupMembers(d *schema.ResourceData, nsxClient *api.APIClient, id string) ([]manager.NsGroupSimpleExpression, error) {
+	localVarOptionals := make(map[string]interface{})
+	nsGroup, _, err := nsxClient.GroupingObjectsApi.ReadNSGroup(nsxClient.Context, id, localVarOptionals)
+	if err != nil {
+		return nil, err
+	}
+
+	oldMembers, newMembers := d.GetChange("member")
+	oldKeys := getNsGroupMemberKeys(oldMembers.(*schema.Set).List())
+	newKeys := getNsGroupMemberKeys(newMembers.(*schema.Set).List())
+	var external []manager.NsGroupSimpleExpression
+	for _, member := range nsGroup.Members {
+		key := getNsGroupMemberKey(member.TargetType, member.Value)
+		if !oldKeys[key] && !newKeys[key] {
+			external = append(external, member)
+		}
+	}
+	return external, nil
+}
+
 func setMembersInSchema(d *schema.ResourceData, members []manager.NsGroupSimpleExpression) error {
 	var expresionList []map[string]interface{}
 	for _, member := range members {
@@ -212,7 +265,11 @@ func resourceNsxtNsGroupRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", nsGroup.Description)
 	d.Set("display_name", nsGroup.DisplayName)
 	setTagsInSchema(d, nsGroup.Tags)
-	err1 := setMembersInSchema(d, nsGroup.Members)
+	members := nsGroup.Members
+	if d.Get("ignore_external_members").(bool) {
+		members = filterNsGroupMembers(d, members)
+	}
+	err1 := setMembersInSchema(d, members)
 
 	err2 := setMembershipCriteriaInSchema(d, nsGroup.MembershipCriteria)
 	if err1 != nil || err2 != nil {
@@ -235,6 +292,14 @@ func resourceNsxtNsGroupUpdate(d *schema.ResourceData, m interface{}) error {
 	tags := getTagsFromSchema(d)
 	members := getMembersFromSchema(d)
 	membershipCriteria := getMembershipCriteriaFromSchema(d)
+	if d.Get("ignore_external_members").(bool) {
+		// Keep the members added outside of this resource
+		externalMembers, err := getExternalNsGroupMembers(d, nsxClient, id)
+		if err != nil {
+			return fmt.Errorf("Error during NsGroup %s read of external members: %v", id, err)
+		}
+		members = append(members, externalMembers...)
+	}
 	nsGroup := manager.NsGroup{
 		Revision:           revision,
 		Description:        description,
@@ -0,0 +1,154 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func resourceNsxtNsGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtNsGroupMemberCreate,
+		Read:   resourceNsxtNsGroupMemberRead,
+		Delete: resourceNsxtNsGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtNsGroupMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ns_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "ID of the NSGroup this member is added to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"target_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Type of the resource on which this expression is evaluated",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(nsGroupTargetTypeValues, false),
+			},
+			"value": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Value that satisfies this expression",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func getNsGroupMemberExpressionList(targetType string, value string) manager.NsGroupSimpleExpressionList {
+	return manager.NsGroupSimpleExpressionList{
+		Members: []manager.NsGroupSimpleExpression{
+			{
+				ResourceType:   "NSGroupSimpleExpression",
+				Op:             "EQUALS",
+				TargetProperty: "id",
+				TargetType:     targetType,
+				Value:          value,
+			},
+		},
+	}
+}
+
+func resourceNsxtNsGroupMemberCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	nsGroupID := d.Get("ns_group_id").(string)
+	targetType := d.Get("target_type").(string)
+	value := d.Get("value").(string)
+	expressionList := getNsGroupMemberExpressionList(targetType, value)
+
+	_, resp, err := nsxClient.GroupingObjectsApi.AddOrRemoveNsGroupSimpleExpression(nsxClient.Context, nsGroupID, expressionList, "ADD_MEMBERS")
+
+	if err != nil {
+		return fmt.Errorf("Error during NsGroup %s member add: %v", nsGroupID, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status returned during NsGroup %s member add: %v", nsGroupID, resp.StatusCode)
+	}
+	d.SetId(value)
+
+	return resourceNsxtNsGroupMemberRead(d, m)
+}
+
+func resourceNsxtNsGroupMemberRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	nsGroupID := d.Get("ns_group_id").(string)
+	if id == "" || nsGroupID == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	nsGroup, resp, err := nsxClient.GroupingObjectsApi.ReadNSGroup(nsxClient.Context, nsGroupID, localVarOptionals)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] NsGroup %s not found", nsGroupID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during NsGroup %s read: %v", nsGroupID, err)
+	}
+
+	targetType := d.Get("target_type").(string)
+	for _, member := range nsGroup.Members {
+		if member.TargetType == targetType && member.Value == id {
+			d.Set("ns_group_id", nsGroupID)
+			d.Set("target_type", member.TargetType)
+			d.Set("value", member.Value)
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] Member %s not found in NsGroup %s", id, nsGroupID)
+	d.SetId("")
+	return nil
+}
+
+func resourceNsxtNsGroupMemberDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	nsGroupID := d.Get("ns_group_id").(string)
+	if id == "" || nsGroupID == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	targetType := d.Get("target_type").(string)
+	expressionList := getNsGroupMemberExpressionList(targetType, id)
+	_, resp, err := nsxClient.GroupingObjectsApi.AddOrRemoveNsGroupSimpleExpression(nsxClient.Context, nsGroupID, expressionList, "REMOVE_MEMBERS")
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] NsGroup %s not found", nsGroupID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during NsGroup %s member delete: %v", nsGroupID, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtNsGroupMemberImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 3 {
+		return nil, fmt.Errorf("Please provide <ns-group-id>/<target-type>/<member-id> as an input")
+	}
+
+	d.SetId(s[2])
+	d.Set("ns_group_id", s[0])
+	d.Set("target_type", s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,177 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"testing"
+)
+
+var testNsxtNsGroupMemberResourceName = "nsxt_ns_group_member.test"
+
+func TestAccResourceNsxtNSGroupMember_basic(t *testing.T) {
+	grpName := fmt.Sprintf("test-nsx-ns-group-shared")
+	testResourceName := testNsxtNsGroupMemberResourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXNSGroupMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXNSGroupMemberCreateTemplate(grpName, "${nsxt_ip_set.set1.id}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXNSGroupMemberExists(testResourceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "ns_group_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "value"),
+					resource.TestCheckResourceAttr(testResourceName, "target_type", "IPSet"),
+				),
+			},
+			{
+				Config: testAccNSXNSGroupMemberCreateTemplate(grpName, "${nsxt_ip_set.set2.id}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXNSGroupMemberExists(testResourceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "ns_group_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "value"),
+					resource.TestCheckResourceAttr(testResourceName, "target_type", "IPSet"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtNSGroupMember_importBasic(t *testing.T) {
+	grpName := fmt.Sprintf("test-nsx-ns-group-shared")
+	testResourceName := testNsxtNsGroupMemberResourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXNSGroupMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXNSGroupMemberCreateTemplate(grpName, "${nsxt_ip_set.set1.id}"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXNSGroupMemberImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNSXNSGroupMemberImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[testNsxtNsGroupMemberResourceName]
+	if !ok {
+		return "", fmt.Errorf("NS Group member %s not found in resources", testNsxtNsGroupMemberResourceName)
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("NS Group member resource ID not set in resources")
+	}
+	nsGroupID := rs.Primary.Attributes["ns_group_id"]
+	if nsGroupID == "" {
+		return "", fmt.Errorf("NS Group member ns_group_id not set in resources")
+	}
+	return fmt.Sprintf("%s/%s/%s", nsGroupID, rs.Primary.Attributes["target_type"], resourceID), nil
+}
+
+func testAccNSXNSGroupMemberIsInGroup(nsGroupID string, memberID string) (bool, error) {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	localVarOptionals := make(map[string]interface{})
+	nsGroup, responseCode, err := nsxClient.GroupingObjectsApi.ReadNSGroup(nsxClient.Context, nsGroupID, localVarOptionals)
+	if err != nil {
+		if responseCode != nil && responseCode.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error while retrieving NS Group ID %s. Error: %v", nsGroupID, err)
+	}
+
+	for _, member := range nsGroup.Members {
+		if member.Value == memberID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func testAccNSXNSGroupMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("NS Group member resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("NS Group member resource ID not set in resources ")
+		}
+
+		nsGroupID := rs.Primary.Attributes["ns_group_id"]
+		found, err := testAccNSXNSGroupMemberIsInGroup(nsGroupID, resourceID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("NS Group member %s wasn't found in NS Group %s", resourceID, nsGroupID)
+		}
+		return nil
+	}
+}
+
+func testAccNSXNSGroupMemberCheckDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_ns_group_member" {
+			continue
+		}
+
+		resourceID := rs.Primary.ID
+		nsGroupID := rs.Primary.Attributes["ns_group_id"]
+		found, err := testAccNSXNSGroupMemberIsInGroup(nsGroupID, resourceID)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("NS Group member %s still exists in NS Group %s", resourceID, nsGroupID)
+		}
+	}
+	return nil
+}
+
+func testAccNSXNSGroupMemberCreateTemplate(name string, memberID string) string {
+	return fmt.Sprintf(`
+resource "nsxt_ns_group" "shared" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  ignore_external_members = true
+}
+
+resource "nsxt_ip_set" "set1" {
+  display_name = "set1"
+  ip_addresses = ["1.1.1.1"]
+}
+
+resource "nsxt_ip_set" "set2" {
+  display_name = "set2"
+  ip_addresses = ["2.2.2.2"]
+}
+
+resource "nsxt_ns_group_member" "test" {
+  ns_group_id = "${nsxt_ns_group.shared.id}"
+  target_type = "IPSet"
+  value       = "%s"
+}`, name, memberID)
+}
@@ -0,0 +1,218 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/aaa"
+	"log"
+	"net/http"
+)
+
+var roleBindingTypeValues = []string{"remote_user", "remote_group"}
+
+func resourceNsxtRoleBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtRoleBindingCreate,
+		Read:   resourceNsxtRoleBindingRead,
+		Update: resourceNsxtRoleBindingUpdate,
+		Delete: resourceNsxtRoleBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "User or group name as known to vIDM",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Type of the binding, either a vIDM user or a vIDM group",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(roleBindingTypeValues, false),
+			},
+			"roles": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "Set of NSX roles granted to the user or group",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// The set of roles depends on the NSX version, so the configured roles are
+// checked against the roles reported by NSX rather than a static list
+func validateRoleBindingRoles(nsxClient *api.APIClient, roles []string) error {
+	roleList, _, err := nsxClient.AaaApi.GetAllRolesInfo(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while reading NSX roles: %v", err)
+	}
+
+	var validRoles []string
+	validRoleMap := make(map[string]bool)
+	for _, role := range roleList.Results {
+		validRoles = append(validRoles, role.Role)
+		validRoleMap[role.Role] = true
+	}
+	for _, role := range roles {
+		if !validRoleMap[role] {
+			return fmt.Errorf("Role %s is not a valid NSX role, expected one of %v", role, validRoles)
+		}
+	}
+	return nil
+}
+
+func getRolesFromSchema(d *schema.ResourceData) []aaa.Role {
+	var roles []aaa.Role
+	for _, role := range getStringListFromSchemaSet(d, "roles") {
+		roles = append(roles, aaa.Role{Role: role})
+	}
+	return roles
+}
+
+func setRolesInSchema(d *schema.ResourceData, roles []aaa.Role) {
+	var roleList []string
+	for _, role := range roles {
+		roleList = append(roleList, role.Role)
+	}
+	d.Set("roles", roleList)
+}
+
+func resourceNsxtRoleBindingCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	name := d.Get("name").(string)
+	bindingType := d.Get("type").(string)
+	err := validateRoleBindingRoles(nsxClient, getStringListFromSchemaSet(d, "roles"))
+	if err != nil {
+		return err
+	}
+	roleBinding := aaa.RoleBinding{
+		Description: description,
+		DisplayName: displayName,
+		Tags:        tags,
+		Name:        name,
+		Type_:       bindingType,
+		Roles:       getRolesFromSchema(d),
+	}
+
+	roleBinding, resp, err := nsxClient.AaaApi.CreateRoleBinding(nsxClient.Context, roleBinding)
+
+	if err != nil {
+		return fmt.Errorf("Error during RoleBinding create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during RoleBinding create: %v", resp.StatusCode)
+	}
+	d.SetId(roleBinding.Id)
+
+	return resourceNsxtRoleBindingRead(d, m)
+}
+
+func resourceNsxtRoleBindingRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	roleBinding, resp, err := nsxClient.AaaApi.GetRoleBinding(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] RoleBinding %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during RoleBinding read: %v", err)
+	}
+
+	d.Set("revision", roleBinding.Revision)
+	d.Set("description", roleBinding.Description)
+	d.Set("display_name", roleBinding.DisplayName)
+	setTagsInSchema(d, roleBinding.Tags)
+	d.Set("name", roleBinding.Name)
+	d.Set("type", roleBinding.Type_)
+	setRolesInSchema(d, roleBinding.Roles)
+
+	return nil
+}
+
+func resourceNsxtRoleBindingUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	revision := int64(d.Get("revision").(int))
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	name := d.Get("name").(string)
+	bindingType := d.Get("type").(string)
+	err := validateRoleBindingRoles(nsxClient, getStringListFromSchemaSet(d, "roles"))
+	if err != nil {
+		return err
+	}
+	roleBinding := aaa.RoleBinding{
+		Revision:    revision,
+		Description: description,
+		DisplayName: displayName,
+		Tags:        tags,
+		Name:        name,
+		Type_:       bindingType,
+		Roles:       getRolesFromSchema(d),
+	}
+
+	roleBinding, resp, err := nsxClient.AaaApi.UpdateRoleBinding(nsxClient.Context, id, roleBinding)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during RoleBinding update: %v", err)
+	}
+
+	return resourceNsxtRoleBindingRead(d, m)
+}
+
+func resourceNsxtRoleBindingDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(*api.APIClient)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.AaaApi.DeleteRoleBinding(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during RoleBinding delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] RoleBinding %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+/* Copyright © 2018 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/vmware/go-vmware-nsxt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceNsxtRoleBinding_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-role-binding")
+	updateName := fmt.Sprintf("%s-update", name)
+	testResourceName := "nsxt_role_binding.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VIDM_USER")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXRoleBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXRoleBindingTemplate(name, `["auditor"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXRoleBindingExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "name", os.Getenv("NSXT_TEST_VIDM_USER")),
+					resource.TestCheckResourceAttr(testResourceName, "type", "remote_user"),
+					resource.TestCheckResourceAttr(testResourceName, "roles.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXRoleBindingTemplate(updateName, `["auditor", "network_engineer"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXRoleBindingExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtRoleBinding_invalidRole(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-role-binding")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VIDM_USER")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXRoleBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNSXRoleBindingTemplate(name, `["no_such_role"]`),
+				ExpectError: regexp.MustCompile("Role no_such_role is not a valid NSX role"),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtRoleBinding_importBasic(t *testing.T) {
+	name := fmt.Sprintf("test-nsx-role-binding")
+	testResourceName := "nsxt_role_binding.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VIDM_USER")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXRoleBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXRoleBindingTemplate(name, `["auditor"]`),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXRoleBindingExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Role binding resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Role binding resource ID not set in resources ")
+		}
+
+		roleBinding, responseCode, err := nsxClient.AaaApi.GetRoleBinding(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving role binding ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if role binding %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == roleBinding.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Role binding %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXRoleBindingCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(*nsxt.APIClient)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_role_binding" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		roleBinding, responseCode, err := nsxClient.AaaApi.GetRoleBinding(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving role binding ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == roleBinding.DisplayName {
+			return fmt.Errorf("Role binding %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXRoleBindingTemplate(displayName string, roles string) string {
+	return fmt.Sprintf(`
+resource "nsxt_role_binding" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  name         = "%s"
+  type         = "remote_user"
+  roles        = %s
+}`, displayName, os.Getenv("NSXT_TEST_VIDM_USER"), roles)
+}
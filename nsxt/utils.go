@@ -506,3 +506,19 @@ func getNSXVersion(nsxClient *api.APIClient) string {
 	log.Printf("[DEBUG] NSX version %s", nodeProperties.NodeVersion)
 	return nodeProperties.NodeVersion
 }
+
+// Callback that reads a single page of a paginated list API, and returns the
+// cursor of the next page, which is empty on the last page
+type pageReader func(localVarOptionals map[string]interface{}) (string, *http.Response, error)
+
+// Call readPage for every page of a paginated list API, starting with the
+// page selected by localVarOptionals
+func readAllPages(localVarOptionals map[string]interface{}, readPage pageReader) (*http.Response, error) {
+	for {
+		cursor, resp, err := readPage(localVarOptionals)
+		if err != nil || cursor == "" {
+			return resp, err
+		}
+		localVarOptionals["cursor"] = cursor
+	}
+}
@@ -4,12 +4,15 @@
 package nsxt
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"net"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Validations for Port objects
@@ -194,6 +197,88 @@ func validateCidr() schema.SchemaValidateFunc {
 	}
 }
 
+// Validations for MAC objects
+func isMacAddress(v string) bool {
+	// Expects 6 pairs of hexadecimal digits separated by colons or dashes
+	if len(v) != 17 {
+		return false
+	}
+	_, err := net.ParseMAC(v)
+	return (err == nil)
+}
+
+func validateMacAddress() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if !isMacAddress(v) {
+			es = append(es, fmt.Errorf(
+				"expected %s to contain a valid MAC address, got: %s", k, v))
+		}
+		return
+	}
+}
+
+// Validations for certificate objects
+
+func parsePemCertificates(v string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(v)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
+
+func validateCertificatePem(warningDays int) schema.SchemaValidateFunc {
+	// Warn about certificates which expire within warningDays days
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		certs, err := parsePemCertificates(v)
+		if err != nil {
+			es = append(es, fmt.Errorf(
+				"expected %s to contain a valid PEM encoded certificate: %v", k, err))
+			return
+		}
+
+		now := time.Now()
+		for _, cert := range certs {
+			if cert.NotAfter.Before(now) {
+				s = append(s, fmt.Sprintf(
+					"certificate %s in %s has expired on %s", cert.Subject.CommonName, k, cert.NotAfter.Format(time.RFC3339)))
+			} else if cert.NotAfter.Before(now.AddDate(0, 0, warningDays)) {
+				s = append(s, fmt.Sprintf(
+					"certificate %s in %s expires on %s, within %d days", cert.Subject.CommonName, k, cert.NotAfter.Format(time.RFC3339), warningDays))
+			}
+		}
+		return
+	}
+}
+
 func isPowerOfTwo(num int) bool {
 	for num >= 2 {
 		if num%2 != 0 {
@@ -230,6 +315,21 @@ func validatePowerOf2(allowZero bool, maxVal int) schema.SchemaValidateFunc {
 	}
 }
 
+func validateFloatBetween(min, max float64) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(float64)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be float", k))
+			return
+		}
+
+		if v < min || v > max {
+			es = append(es, fmt.Errorf("expected %s to be in the range (%v - %v), got %v", k, min, max, v))
+		}
+		return
+	}
+}
+
 var supportedSSLProtocols = []string{"SSL_V2", "SSL_V3", "TLS_V1", "TLS_V1_1", "TLS_V1_2"}
 
 func validateSSLProtocols() schema.SchemaValidateFunc {